@@ -0,0 +1,7 @@
+data "geoserver_mosaic_granules" "sst_2024" {
+  workspace_name     = geoserver_workspace.my_workspace.name
+  coveragestore_name = "sea_surface_temperature"
+  coverage_name      = "sea_surface_temperature"
+  filter             = "location LIKE '/mnt/data/sst/sst_2024%'"
+  time_attribute     = "ingestion"
+}
@@ -5,18 +5,22 @@ resource "geoserver_featuretype" "borehole" {
   native_name    = "borehole"
   enabled        = true
 
-  lat_lon_bounding_box_max_x     = 180
-  lat_lon_bounding_box_max_y     = 90
-  lat_lon_bounding_box_min_x     = -180
-  lat_lon_bounding_box_min_y     = -90
-  lat_lon_bounding_box_crs_value = "EPSG:4326"
+  lat_lon_bounding_box {
+    max_x     = 180
+    max_y     = 90
+    min_x     = -180
+    min_y     = -90
+    crs_value = "EPSG:4326"
+  }
 
-  native_bounding_box_max_x     = 180
-  native_bounding_box_max_y     = 90
-  native_bounding_box_min_x     = -180
-  native_bounding_box_min_y     = -90
-  native_bounding_box_crs_class = ""
-  native_bounding_box_crs_value = "EPSG:4326"
+  native_bounding_box {
+    max_x     = 180
+    max_y     = 90
+    min_x     = -180
+    min_y     = -90
+    crs_class = ""
+    crs_value = "EPSG:4326"
+  }
 
   projection_policy = "FORCE_DECLARED"
 
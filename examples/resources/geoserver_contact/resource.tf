@@ -0,0 +1,24 @@
+resource "geoserver_contact" "global" {
+  contact_person       = "Jane Doe"
+  contact_position     = "GIS administrator"
+  contact_organization = "Example Corp."
+  contact_email        = "gis@example.com"
+  contact_voice        = "+41 21 000 00 00"
+
+  address_type        = "Work"
+  address             = "Avenue de la Gare 1"
+  address_city        = "Lausanne"
+  address_postal_code = "1003"
+  address_country     = "Switzerland"
+
+  online_resource = "https://example.com"
+
+  international_contact_position = {
+    en = "GIS administrator"
+    fr = "Administratrice SIG"
+  }
+  international_address_country = {
+    en = "Switzerland"
+    fr = "Suisse"
+  }
+}
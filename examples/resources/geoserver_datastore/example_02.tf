@@ -28,11 +28,15 @@ resource "geoserver_datastore" "db-referentiels-carto-osm" {
     "max connections"                            = "15"
     "min connections"                            = "1"
     "namespace"                                  = "nexsis"
-    "passwd"                                     = var.referentiels_carto_db_config.PASSWORD
     "port"                                       = "5432"
     "preparedStatements"                         = "false"
     "schema"                                     = "osm"
     "user"                                       = var.referentiels_carto_db_config.ROLE
     "validate connections"                       = "true"
   }
+
+  # Geoserver returns the password encrypted, it is not read back
+  sensitive_connection_params = {
+    "passwd" = var.referentiels_carto_db_config.PASSWORD
+  }
 }
\ No newline at end of file
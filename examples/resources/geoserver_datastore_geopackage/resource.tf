@@ -0,0 +1,6 @@
+resource "geoserver_datastore_geopackage" "roads" {
+  workspace_name = geoserver_workspace.fdp.name
+  name           = "roads"
+  database       = "file:data/roads.gpkg"
+  read_only      = true
+}
@@ -0,0 +1,24 @@
+resource "geoserver_datastore_postgis" "referentiels" {
+  workspace_name = geoserver_workspace.fdp.name
+  name           = "referentiels"
+  description    = "Reference data"
+
+  host     = "postgis.example.com"
+  port     = 5432
+  database = "referentiels"
+  schema   = "bd_topo"
+  user     = "geoserver"
+  password = var.postgis_password
+
+  max_connections     = 20
+  prepared_statements = true
+  estimated_extends   = false
+  expose_primary_keys = true
+}
+
+resource "geoserver_datastore_postgis" "jndi" {
+  workspace_name      = geoserver_workspace.fdp.name
+  name                = "jndi"
+  jndi_reference_name = "java:comp/env/jdbc/referentiels"
+  schema              = "bd_topo"
+}
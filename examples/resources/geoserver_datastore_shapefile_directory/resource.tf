@@ -0,0 +1,6 @@
+resource "geoserver_datastore_shapefile_directory" "shapefiles" {
+  workspace_name = geoserver_workspace.fdp.name
+  name           = "shapefiles"
+  url            = "file:data/shapefiles"
+  charset        = "UTF-8"
+}
@@ -0,0 +1,9 @@
+resource "geoserver_datastore_wfs" "remote" {
+  workspace_name   = geoserver_workspace.fdp.name
+  name             = "remote"
+  capabilities_url = "https://data.example.com/geoserver/wfs?service=WFS&request=GetCapabilities&version=2.0.0"
+  username         = "reader"
+  password         = var.remote_wfs_password
+  timeout          = 10000
+  max_features     = 5000
+}
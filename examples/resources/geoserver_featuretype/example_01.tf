@@ -5,25 +5,50 @@ resource "geoserver_featuretype" "river" {
   native_name    = "river"
   enabled        = true
 
-  lat_lon_bounding_box_max_x     = 180
-  lat_lon_bounding_box_max_y     = 90
-  lat_lon_bounding_box_min_x     = -180
-  lat_lon_bounding_box_min_y     = -90
-  lat_lon_bounding_box_crs_value = "EPSG:4326"
+  lat_lon_bounding_box {
+    max_x     = 180
+    max_y     = 90
+    min_x     = -180
+    min_y     = -90
+    crs_value = "EPSG:4326"
+  }
 
-  native_bounding_box_max_x     = 180
-  native_bounding_box_max_y     = 90
-  native_bounding_box_min_x     = -180
-  native_bounding_box_min_y     = -90
-  native_bounding_box_crs_class = ""
-  native_bounding_box_crs_value = "EPSG:4326"
+  native_bounding_box {
+    max_x     = 180
+    max_y     = 90
+    min_x     = -180
+    min_y     = -90
+    crs_class = ""
+    crs_value = "EPSG:4326"
+  }
 
   projection_policy = "FORCE_DECLARED"
 
   srs = "EPSG:4326"
 
+  sql_view {
+    name            = "river"
+    sql             = <<-EOT
+      select
+      fo_water_withdrawal.bu_code as bu_code,
+      asset_gid as asset_gid,
+      asset_gid as asset_gid_pk,
+      asset_name as installation_name,
+      functional_location_geometry as asset_geometry
+      from public.fo_water_withdrawal
+      LEFT JOIN fo_water_resource
+      ON fo_water_withdrawal.resource_gid = fo_water_resource.resource_gid
+      where fo_water_withdrawal.water_withdrawal_type_level_1_code = '%level_1_code%'
+    EOT
+    key_columns     = ["asset_gid_pk"]
+    geometry_column = "asset_geometry"
+    geometry_type   = "Point"
+    geometry_srid   = 4326
 
-  metadata = {
-    "JDBC_VIRTUAL_TABLE" = "\n      \u003cvirtualTable\u003e\n        \u003cname\u003eriver\u003c/name\u003e\n        \u003csql\u003eselect\u0026#xd;\nfo_water_withdrawal.bu_code as bu_code,\u0026#xd;\nasset_gid as asset_gid,\u0026#xd;\nasset_gid as asset_gid_pk,\u0026#xd;\noperational_contract_gid as contract_gid,\u0026#xd;\noperational_contract_id as contract_id,\u0026#xd;\nasset_name as installation_name,\u0026#xd;\nwater_withdrawal_type_level_2_name as asset_subtype_name,\u0026#xd;\nfunctional_location_geometry as asset_geometry\u0026#xd;\nfrom public.fo_water_withdrawal\u0026#xd;\nLEFT JOIN fo_water_resource\u0026#xd;\nON fo_water_withdrawal.resource_gid = fo_water_resource.resource_gid\u0026#xd;\nwhere  fo_water_withdrawal.water_withdrawal_type_level_1_code = \u0026apos;SurfaceWaterPoint\u0026apos;\u0026#xd;\nAND fo_water_resource.resource_type_code = \u0026apos;WaterCourseSection\u0026apos;\n\u003c/sql\u003e\n        \u003cescapeSql\u003efalse\u003c/escapeSql\u003e\n        \u003ckeyColumn\u003easset_gid_pk\u003c/keyColumn\u003e\n        \u003cgeometry\u003e\n          \u003cname\u003easset_geometry\u003c/name\u003e\n          \u003ctype\u003ePoint\u003c/type\u003e\n          \u003csrid\u003e4326\u003c/srid\u003e\n        \u003c/geometry\u003e\n      \u003c/virtualTable\u003e\n    "
+    parameter {
+      name             = "level_1_code"
+      default_value    = "SurfaceWaterPoint"
+      regexp_validator = "^[\\w]+$"
+    }
   }
 }
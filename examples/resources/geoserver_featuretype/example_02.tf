@@ -5,17 +5,21 @@ resource "geoserver_featuretype" "bd_topo_batiment" {
   native_name    = "batiment"
   enabled        = true
 
-  lat_lon_bounding_box_max_x     = 55.83018112182617
-  lat_lon_bounding_box_max_y     = 51.08795166015625
-  lat_lon_bounding_box_min_x     = -63.152530670166016
-  lat_lon_bounding_box_min_y     = -21.387481689453125
-  lat_lon_bounding_box_crs_value = "EPSG:4326"
+  lat_lon_bounding_box {
+    max_x     = 55.83018112182617
+    max_y     = 51.08795166015625
+    min_x     = -63.152530670166016
+    min_y     = -21.387481689453125
+    crs_value = "EPSG:4326"
+  }
 
-  native_bounding_box_max_x     = 55.83018112182617
-  native_bounding_box_max_y     = 51.08795166015625
-  native_bounding_box_min_x     = -63.152530670166016
-  native_bounding_box_min_y     = -21.387481689453125
-  native_bounding_box_crs_value = "EPSG:4326"
+  native_bounding_box {
+    max_x     = 55.83018112182617
+    max_y     = 51.08795166015625
+    min_x     = -63.152530670166016
+    min_y     = -21.387481689453125
+    crs_value = "EPSG:4326"
+  }
 
   projection_policy = "FORCE_DECLARED"
 
@@ -0,0 +1,40 @@
+resource "geoserver_featuretype" "observations" {
+  workspace_name    = geoserver_workspace.my_workspace.name
+  datastore_name    = geoserver_datastore.my_datastore.name
+  name              = "observations"
+  native_name       = "observations"
+  projection_policy = "FORCE_DECLARED"
+  srs               = "EPSG:4326"
+
+  create_native = true
+
+  native_geometry {
+    name = "geom"
+    type = "Point"
+    srid = 4326
+  }
+
+  attribute {
+    name       = "observer"
+    binding    = "java.lang.String"
+    min_occurs = 0
+    max_occurs = 1
+    nillable   = true
+  }
+
+  attribute {
+    name       = "observed_at"
+    binding    = "java.sql.Timestamp"
+    min_occurs = 1
+    max_occurs = 1
+    nillable   = false
+  }
+
+  time_dimension {
+    attribute              = "observed_at"
+    presentation           = "CONTINUOUS_INTERVAL"
+    units                  = "ISO8601"
+    default_value_strategy = "MAXIMUM"
+    nearest_match_enabled  = true
+  }
+}
@@ -0,0 +1,49 @@
+resource "geoserver_featuretype" "roads" {
+  workspace_name    = geoserver_workspace.fdp.name
+  datastore_name    = geoserver_datastore.roads.name
+  name              = "roads"
+  native_name       = "roads"
+  projection_policy = "FORCE_DECLARED"
+  srs               = "EPSG:2154"
+
+  international_title = {
+    en = "Roads"
+    fr = "Routes"
+  }
+  international_abstract = {
+    en = "Road network of the agency"
+    fr = "Réseau routier de l'agence"
+  }
+
+  keywords {
+    value = "roads"
+  }
+
+  keywords {
+    value      = "routes"
+    language   = "fr"
+    vocabulary = "GEMET"
+  }
+
+  metadata_link {
+    type          = "text/xml"
+    metadata_type = "ISO19115:2003"
+    content       = "https://catalog.example.com/srv/api/records/roads/formatters/xml"
+  }
+
+  data_link {
+    type    = "text/html"
+    content = "https://data.example.com/roads"
+  }
+
+  overriding_service_srs = true
+  response_srs           = ["2154", "4326", "3857"]
+
+  service_configuration = true
+  disabled_services     = ["WCS"]
+
+  max_features        = 10000
+  num_decimals        = 2
+  cql_filter          = "status = 'open'"
+  skip_number_matched = true
+}
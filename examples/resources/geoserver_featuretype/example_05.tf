@@ -0,0 +1,15 @@
+resource "geoserver_featuretype" "parcels" {
+  workspace_name    = geoserver_workspace.fdp.name
+  datastore_name    = geoserver_datastore.cadastre.name
+  name              = "parcels"
+  native_name       = "parcels"
+  projection_policy = "FORCE_DECLARED"
+  srs               = "EPSG:2154"
+
+  recalculate = "nativebbox,latlonbbox"
+
+  # Bounding boxes are computed again after each import of the cadastre
+  refresh_bounds_on = {
+    import_date = var.cadastre_import_date
+  }
+}
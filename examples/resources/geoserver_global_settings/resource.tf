@@ -0,0 +1,20 @@
+resource "geoserver_global_settings" "global" {
+  proxy_base_url        = "https://$${X-Forwarded-Host}/geoserver"
+  use_headers_proxy_url = true
+
+  num_decimals       = 8
+  verbose_exceptions = false
+
+  resource_error_handling = "SKIP_MISCONFIGURED_LAYERS"
+
+  jai_tile_cache_memory_capacity = 0.5
+  jai_tile_threads               = 7
+
+  coverage_access_core_pool_size = 5
+  coverage_access_max_pool_size  = 10
+
+  contact {
+    contact_organization = "Example Corp."
+    contact_email        = "gis@example.com"
+  }
+}
@@ -0,0 +1,12 @@
+resource "geoserver_user_group" "cartographers" {
+  name = "cartographers"
+}
+
+resource "geoserver_role" "editor" {
+  name = "ROLE_EDITOR"
+}
+
+resource "geoserver_group_role" "cartographers_editor" {
+  group_name = geoserver_user_group.cartographers.name
+  role_name  = geoserver_role.editor.name
+}
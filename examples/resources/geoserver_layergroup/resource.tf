@@ -1,14 +1,17 @@
 # Example 1. Styles are in the same workspace and referenced by name
 resource "geoserver_layergroup" "fdp_normal" {
-  name                   = "fdp_normal"
-  title                  = "fdp_normal"
+  name           = "fdp_normal"
+  title          = "fdp_normal"
   workspace_name = geoserver_workspace.my_workspace.name
-  bounding_box_crs_class = "projected"
-  bounding_box_crs_value = "EPSG:4326"
-  bounding_box_min_x      = -63.16
-  bounding_box_min_y      = -21.40
-  bounding_box_max_x      = 55.84
-  bounding_box_max_y      = 51.20
+
+  bounding_box {
+    crs_class = "projected"
+    crs_value = "EPSG:4326"
+    min_x     = -63.16
+    min_y     = -21.40
+    max_x     = 55.84
+    max_y     = 51.20
+  }
 
   layers {
     name  = geoserver_featuretype.bd_carto_zone_occupation_sol_vue_foret.name
@@ -55,15 +58,18 @@ resource "geoserver_layergroup" "fdp_normal" {
 
 # Example 2. Styles are stored in the same workspace but we generate qualified names for the reference
 resource "geoserver_layergroup" "osm_fdp_normal" {
-  name                   = "fdp_normal"
-  title                  = "fdp_normal"
+  name           = "fdp_normal"
+  title          = "fdp_normal"
   workspace_name = geoserver_workspace.my_workspace.name
-  bounding_box_crs_class = "projected"
-  bounding_box_crs_value = "EPSG:3857"
-  bounding_box_max_x     = 20237886
-  bounding_box_max_y     = 20237886
-  bounding_box_min_x     = -20237886
-  bounding_box_min_y     = -20237886
+
+  bounding_box {
+    crs_class = "projected"
+    crs_value = "EPSG:3857"
+    max_x     = 20237886
+    max_y     = 20237886
+    min_x     = -20237886
+    min_y     = -20237886
+  }
 
   layers {
     name = format("%s:%s",geoserver_workspace.osm.name,geoserver_featuretype.osm_simplified_water_polygons.name)
@@ -78,3 +84,35 @@ resource "geoserver_layergroup" "osm_fdp_normal" {
     style = format("%s:%s",geoserver_workspace.osm.name,geoserver_style.osm_coast_poly.name)
   }
 }
+
+# Example 3. Title, abstract and keywords translated in several languages
+resource "geoserver_layergroup" "basemap" {
+  name           = "basemap"
+  title          = "Basemap"
+  workspace_name = geoserver_workspace.my_workspace.name
+
+  international_title = {
+    en = "Basemap"
+    fr = "Fond de plan"
+    de = "Grundkarte"
+    it = "Mappa di base"
+  }
+
+  keywords = ["basemap"]
+
+  international_keywords {
+    value    = "fond de plan"
+    language = "fr"
+  }
+
+  international_keywords {
+    value      = "Grundkarte"
+    language   = "de"
+    vocabulary = "GEMET"
+  }
+
+  layers {
+    name  = geoserver_featuretype.roads.name
+    style = geoserver_style.roads.name
+  }
+}
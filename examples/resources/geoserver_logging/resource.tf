@@ -0,0 +1,11 @@
+resource "geoserver_logging" "logging" {
+  level          = "INCIDENT_LOGGING"
+  location       = "logs/geoserver.log"
+  stdout_logging = true
+
+  # Uploaded as logs/INCIDENT_LOGGING.xml in the data directory
+  custom_profile = file("${path.module}/INCIDENT_LOGGING.xml")
+
+  request_logging_enabled = true
+  request_logging_headers = true
+}
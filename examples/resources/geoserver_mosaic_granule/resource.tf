@@ -0,0 +1,6 @@
+resource "geoserver_mosaic_granule" "sst_20240101" {
+  workspace_name     = geoserver_workspace.my_workspace.name
+  coveragestore_name = "sea_surface_temperature"
+  coverage_name      = "sea_surface_temperature"
+  path               = "/mnt/data/sst/sst_20240101.tif"
+}
@@ -0,0 +1,9 @@
+resource "geoserver_role" "editor" {
+  name = "ROLE_EDITOR"
+}
+
+# Role of a non-default role service
+resource "geoserver_role" "ldap_reader" {
+  service_name = "ldap"
+  name         = "ROLE_READER"
+}
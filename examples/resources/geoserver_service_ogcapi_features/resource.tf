@@ -0,0 +1,17 @@
+resource "geoserver_service_ogcapi_features" "global" {
+  enabled  = true
+  title    = "My OGC API Features"
+  abstract = "Vector data of Example Corp."
+
+  max_features_per_page = 5000
+
+  conformance {
+    cql2_text        = true
+    cql2_json        = true
+    sorting          = true
+    crs_by_reference = true
+  }
+
+  # Published alongside the WFS, which publishes the same feature types
+  depends_on = [geoserver_service_wfs.global]
+}
@@ -0,0 +1,3 @@
+resource "geoserver_service_ogcapi_styles" "global" {
+  enabled = false
+}
@@ -0,0 +1,4 @@
+resource "geoserver_service_ogcapi_tiles" "global" {
+  enabled = true
+  title   = "My OGC API Tiles"
+}
@@ -0,0 +1,12 @@
+resource "geoserver_service_wcs" "global" {
+  enabled            = true
+  title              = "My WCS"
+  supported_versions = ["1.0.0", "1.1.1", "2.0.1"]
+
+  max_input_memory  = 2097152
+  max_output_memory = 524288
+
+  gml_prefixing         = false
+  default_deflate_level = 6
+  overview_policy       = "QUALITY"
+}
@@ -0,0 +1,22 @@
+resource "geoserver_service_wfs" "global" {
+  enabled  = true
+  title    = "My WFS"
+  abstract = "Vector data of Example Corp."
+  keywords = ["features", "vector"]
+
+  service_level = "BASIC"
+  max_features  = 50000
+
+  hits_ignore_max_features  = true
+  canonical_schema_location = true
+
+  gml32_srs_name_style = "URN2"
+}
+
+resource "geoserver_service_wfs" "tenant" {
+  workspace_name = geoserver_workspace.tenant.name
+
+  enabled       = true
+  title         = "Tenant WFS"
+  service_level = "TRANSACTIONAL"
+}
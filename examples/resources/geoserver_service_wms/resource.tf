@@ -0,0 +1,23 @@
+resource "geoserver_service_wms" "global" {
+  enabled  = true
+  title    = "Maps"
+  abstract = "Maps of Example Corp."
+
+  international_title = {
+    en = "Maps"
+    fr = "Cartes"
+    de = "Karten"
+    it = "Mappe"
+  }
+  international_abstract = {
+    en = "Maps of Example Corp."
+    fr = "Cartes de Example Corp."
+  }
+
+  keywords = ["maps"]
+
+  international_keywords {
+    value    = "cartes"
+    language = "fr"
+  }
+}
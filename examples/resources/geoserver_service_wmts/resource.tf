@@ -0,0 +1,7 @@
+resource "geoserver_service_wmts" "global" {
+  enabled         = true
+  title           = "My WMTS"
+  abstract        = "Cached maps of Example Corp."
+  keywords        = ["tiles", "maps"]
+  online_resource = "https://example.com/maps"
+}
@@ -0,0 +1,29 @@
+resource "geoserver_service_wps" "global" {
+  enabled = true
+  title   = "My WPS"
+
+  connection_timeout          = 60
+  resource_expiration_timeout = 600
+
+  max_synchronous_processes      = 4
+  max_synchronous_execution_time = 120
+  max_asynchronous_total_time    = 3600
+
+  storage_directory = "/var/lib/geoserver/wps"
+
+  process_group {
+    factory_class = "org.geoserver.wps.jts.SpringBeanProcessFactory"
+    enabled       = true
+
+    process {
+      name    = "gs:Import"
+      enabled = true
+      roles   = ["ADMIN"]
+    }
+  }
+
+  process_group {
+    factory_class = "org.geotools.process.raster.RasterProcessFactory"
+    enabled       = false
+  }
+}
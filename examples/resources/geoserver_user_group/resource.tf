@@ -0,0 +1,9 @@
+resource "geoserver_user_group" "cartographers" {
+  name = "cartographers"
+}
+
+# Group of a non-default user/group service
+resource "geoserver_user_group" "ldap_reviewers" {
+  service_name = "ldap"
+  name         = "reviewers"
+}
@@ -0,0 +1,15 @@
+# The group holds exactly the listed users
+resource "geoserver_user_group_membership" "cartographers" {
+  group_name = geoserver_user_group.cartographers.name
+  users = [
+    geoserver_user.jdoe.name,
+    geoserver_user.asmith.name,
+  ]
+}
+
+# The listed users are added to the group, its other members are left as they are
+resource "geoserver_user_group_membership" "reviewers" {
+  group_name    = geoserver_user_group.reviewers.name
+  users         = [geoserver_user.jdoe.name]
+  authoritative = false
+}
@@ -0,0 +1,14 @@
+resource "geoserver_user" "jdoe" {
+  name     = "jdoe"
+  enabled  = true
+  password = var.jdoe_password
+}
+
+resource "geoserver_role" "editor" {
+  name = "ROLE_EDITOR"
+}
+
+resource "geoserver_user_role" "jdoe_editor" {
+  user_name = geoserver_user.jdoe.name
+  role_name = geoserver_role.editor.name
+}
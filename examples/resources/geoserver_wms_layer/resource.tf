@@ -1,24 +1,30 @@
 resource "geoserver_wms_layer" "plan_ign" {
-  workspace_name                 = geoserver_workspace.ign.name
-  wmsstore_name                  = geoserver_wms_store.geoplateforme.name
-  enabled                        = true
-  lat_lon_bounding_box_crs_value = "EPSG:4326"
-  lat_lon_bounding_box_max_x     = 175
-  lat_lon_bounding_box_max_y     = 85
-  lat_lon_bounding_box_min_x     = -175
-  lat_lon_bounding_box_min_y     = -85
-  metadata = {
+  workspace_name = geoserver_workspace.ign.name
+  wmsstore_name  = geoserver_wms_store.geoplateforme.name
+  enabled        = true
+  metadata       = {
     "OTHER_SRS" = "CRS:84,EPSG:2154,IGNF:LAMB93,EPSG:21781,EPSG:23030,EPSG:23031,EPSG:23032,EPSG:27561,IGNF:LAMB1,EPSG:27562,IGNF:LAMB2,EPSG:27563,IGNF:LAMB3,EPSG:27564,IGNF:LAMB4,EPSG:27571,IGNF:LAMB1C,EPSG:27572,IGNF:LAMB2C,IGNF:LAMBE,EPSG:27573,IGNF:LAMB3C,EPSG:27574,IGNF:LAMB4C,EPSG:27581,EPSG:27582,EPSG:27583,EPSG:27584,EPSG:27591,EPSG:27592,EPSG:27593,EPSG:27594,EPSG:2969,IGNF:GUADFM49U20,EPSG:2970,IGNF:GUAD48UTM20,EPSG:2971,IGNF:CSG67UTM22,EPSG:2972,IGNF:UTM22RGFG95,EPSG:2973,IGNF:MART38UTM20,EPSG:2975,IGNF:RGR92UTM40S,EPSG:2976,IGNF:TAHI51UTM06S,EPSG:2977,EPSG:2978,EPSG:2980,IGNF:MAYO50UTM38S,EPSG:2981,EPSG:2987,IGNF:STPM50UTM21,EPSG:2988,IGNF:WALL78UTM1S,EPSG:2989,EPSG:2990,EPSG:3034,EPSG:3035,EPSG:3042,EPSG:3043,EPSG:3044,EPSG:3170,EPSG:3171,EPSG:3172,EPSG:32620,IGNF:UTM20W84MART,EPSG:32621,EPSG:32622,EPSG:32630,IGNF:UTM30W84,EPSG:32631,IGNF:UTM31W84,EPSG:32632,IGNF:UTM32W84,EPSG:32701,IGNF:UTM01SW84,EPSG:32705,EPSG:32706,EPSG:32707,EPSG:32738,EPSG:32739,IGNF:UTM39SW84,EPSG:32740,EPSG:3296,IGNF:RGPFUTM5S,EPSG:3297,IGNF:RGPFUTM6S,EPSG:3298,IGNF:RGPFUTM7S,EPSG:3302,IGNF:IGN63UTM7S,EPSG:3303,EPSG:3304,IGNF:TAHI79UTM6S,EPSG:3305,EPSG:3306,EPSG:3727,EPSG:3857,EPSG:3942,IGNF:RGF93CC42,EPSG:3943,IGNF:RGF93CC43,EPSG:3944,IGNF:RGF93CC44,EPSG:3945,IGNF:RGF93CC45,EPSG:3946,IGNF:RGF93CC46,EPSG:3947,IGNF:RGF93CC47,EPSG:3948,IGNF:RGF93CC48,EPSG:3949,IGNF:RGF93CC49,EPSG:3950,IGNF:RGF93CC50,EPSG:4171,IGNF:RGF93G,EPSG:4258,EPSG:4275,EPSG:4326,EPSG:4463,EPSG:4467,IGNF:RGSPM06U21,EPSG:4470,EPSG:4471,IGNF:RGM04UTM38S,EPSG:4558,EPSG:4559,EPSG:4621,EPSG:4622,EPSG:4623,EPSG:4624,EPSG:4625,EPSG:4626,EPSG:4627,EPSG:4628,EPSG:4629,EPSG:4630,EPSG:4632,EPSG:4633,EPSG:4636,EPSG:4637,EPSG:4638,EPSG:4639,EPSG:4641,EPSG:4642,EPSG:4643,EPSG:4644,EPSG:4687,EPSG:4688,EPSG:4689,EPSG:4690,EPSG:4691,EPSG:4692,EPSG:4749,EPSG:4807,IGNF:CSG67GEO,IGNF:GUAD48GEO,IGNF:GUADFM49GEO,IGNF:MART38GEO,IGNF:MAYO50GEO,IGNF:REUN47GAUSSL,IGNF:REUN47GEO,IGNF:RGFG95GEO,IGNF:STPM50GEO,IGNF:WGS84G"
   }
-  name                          = "plan_ign"
-  native_bounding_box_crs_class = "projected"
-  native_bounding_box_crs_value = "EPSG:3857"
-  native_bounding_box_max_x     = 19480910.888822876
-  native_bounding_box_max_y     = 19971868.880408563
-  native_bounding_box_min_x     = -19480910.888822876
-  native_bounding_box_min_y     = -19971868.88040857
-  native_crs_class              = "projected"
-  native_name                   = "GEOGRAPHICALGRIDSYSTEMS.PLANIGNV2"
-  projection_policy             = "FORCE_DECLARED"
-  srs                           = "EPSG:3857"
+  name              = "plan_ign"
+  native_crs_class  = "projected"
+  native_name       = "GEOGRAPHICALGRIDSYSTEMS.PLANIGNV2"
+  projection_policy = "FORCE_DECLARED"
+  srs               = "EPSG:3857"
+
+  lat_lon_bounding_box {
+    crs_value = "EPSG:4326"
+    max_x     = 175
+    max_y     = 85
+    min_x     = -175
+    min_y     = -85
+  }
+
+  native_bounding_box {
+    crs_class = "projected"
+    crs_value = "EPSG:3857"
+    max_x     = 19480910.888822876
+    max_y     = 19971868.880408563
+    min_x     = -19480910.888822876
+    min_y     = -19971868.88040857
+  }
 }
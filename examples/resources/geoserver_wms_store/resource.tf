@@ -10,4 +10,7 @@ resource "geoserver_wms_store" "geoplateforme" {
   max_connections = 10
   read_timeout = 20
   connection_timeout = 10
+
+  # Fail the apply when Geoserver cannot fetch the capabilities
+  validate_connection = true
 }
@@ -1,3 +1,5 @@
 resource "geoserver_workspace" "my_workspace" {
-  name = "my_workspace"
+  name          = "my_workspace"
+  namespace_uri = "https://example.com/my_workspace"
+  default       = true
 }
@@ -0,0 +1,18 @@
+resource "geoserver_workspace_settings" "tenant" {
+  workspace_name = geoserver_workspace.tenant.name
+
+  charset            = "UTF-8"
+  num_decimals       = 6
+  proxy_base_url     = "https://tenant.example.com/geoserver"
+  verbose_exceptions = false
+
+  local_workspace_includes_prefix = false
+
+  contact {
+    contact_person       = "Jane Doe"
+    contact_organization = "Tenant Corp."
+    contact_email        = "gis@tenant.example.com"
+    address_city         = "Lausanne"
+    address_country      = "Switzerland"
+  }
+}
@@ -0,0 +1,23 @@
+package geoserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func adoptExistingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Adopt the object when it already exists in Geoserver: Create updates it to match the configuration instead of failing. Overrides the adopt_existing setting of the provider when set.",
+	}
+}
+
+// adoptExisting tells whether Create adopts an existing object, the attribute of the resource overriding the provider setting
+func adoptExisting(d *schema.ResourceData, meta interface{}) bool {
+	// GetOkExists tells an explicit false apart from an unset attribute
+	if v, ok := d.GetOkExists("adopt_existing"); ok {
+		return v.(bool)
+	}
+
+	return meta.(*Config).AdoptExisting
+}
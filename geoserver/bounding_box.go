@@ -0,0 +1,232 @@
+package geoserver
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+
+	gs "github.com/camptocamp/go-geoserver/client"
+)
+
+func recalculateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"native_bounding_box", "lat_lon_bounding_box"},
+		Description:   "Bounding boxes computed by Geoserver from the data on create and update. Authorized values are : nativebbox, nativebbox,latlonbbox. The bounding boxes are taken from the configuration when empty, and must not be set otherwise.",
+		ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+			v := val.(string)
+			allowed_values := []string{"nativebbox", "nativebbox,latlonbbox"}
+			if !slices.Contains(allowed_values, v) {
+				errs = append(errs, fmt.Errorf("%q must be one of this values %q, got: %q", key, strings.Join(allowed_values, ","), v))
+			}
+			return
+		},
+	}
+}
+
+func refreshBoundsOnSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeMap,
+		Optional:     true,
+		RequiredWith: []string{"recalculate"},
+		Description:  "Arbitrary values triggering the computation of the bounding boxes when they change, e.g. the row count or the last update date of the data. Requires recalculate.",
+		Elem:         &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// recalculatePath appends the recalculate parameter of the resource to a REST path
+func recalculatePath(d *schema.ResourceData, path string) string {
+	recalculate := d.Get("recalculate").(string)
+	if recalculate == "" {
+		return path
+	}
+
+	return fmt.Sprintf("%s?recalculate=%s", path, url.QueryEscape(recalculate))
+}
+
+// recalculateBounds makes Geoserver compute the bounding boxes of a resource,
+// with an update only carrying its name and its enabled flag
+func recalculateBounds(d *schema.ResourceData, meta interface{}, path string, root string) error {
+	if d.Get("recalculate").(string) == "" {
+		return nil
+	}
+
+	client := meta.(*Config).RestClient()
+
+	return client.SendJSON(http.MethodPut, recalculatePath(d, path), map[string]interface{}{
+		root: map[string]interface{}{
+			"name":    d.Get("name").(string),
+			"enabled": d.Get("enabled").(bool),
+		},
+	})
+}
+
+// boundingBoxTolerance is the relative difference under which two bounding box coordinates are considered equal
+const boundingBoxTolerance = 1e-9
+
+// boundingBoxFields are the attributes of a bounding box block, flattened as <block>_<field> before schema version 1
+var boundingBoxFields = []string{"min_x", "max_x", "min_y", "max_y", "crs_class", "crs_value"}
+
+func boundingBoxSchema(description string, forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		ForceNew:    forceNew,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"min_x": {
+					Type:             schema.TypeFloat,
+					Required:         true,
+					ForceNew:         forceNew,
+					DiffSuppressFunc: suppressCoordinateDiff,
+					Description:      "Minimum X coordinate.",
+				},
+				"max_x": {
+					Type:             schema.TypeFloat,
+					Required:         true,
+					ForceNew:         forceNew,
+					DiffSuppressFunc: suppressCoordinateDiff,
+					Description:      "Maximum X coordinate.",
+				},
+				"min_y": {
+					Type:             schema.TypeFloat,
+					Required:         true,
+					ForceNew:         forceNew,
+					DiffSuppressFunc: suppressCoordinateDiff,
+					Description:      "Minimum Y coordinate.",
+				},
+				"max_y": {
+					Type:             schema.TypeFloat,
+					Required:         true,
+					ForceNew:         forceNew,
+					DiffSuppressFunc: suppressCoordinateDiff,
+					Description:      "Maximum Y coordinate.",
+				},
+				"crs_class": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					ForceNew:    forceNew,
+					Description: "Class of the CRS, e.g. projected.",
+				},
+				"crs_value": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					ForceNew:    forceNew,
+					Description: "CRS of the coordinates, e.g. EPSG:4326.",
+				},
+			},
+		},
+	}
+}
+
+// suppressCoordinateDiff ignores the rounding of the coordinates by Geoserver
+func suppressCoordinateDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldValue, err := strconv.ParseFloat(old, 64)
+	if err != nil {
+		return false
+	}
+	newValue, err := strconv.ParseFloat(new, 64)
+	if err != nil {
+		return false
+	}
+
+	scale := math.Max(1, math.Max(math.Abs(oldValue), math.Abs(newValue)))
+	return math.Abs(oldValue-newValue) <= boundingBoxTolerance*scale
+}
+
+// expandBoundingBox returns the bounding box of a block, zero if not set
+func expandBoundingBox(d *schema.ResourceData, block string) gs.BoundingBox {
+	boxes := d.Get(block).([]interface{})
+	if len(boxes) == 0 || boxes[0] == nil {
+		return gs.BoundingBox{}
+	}
+	v := boxes[0].(map[string]interface{})
+
+	return gs.BoundingBox{
+		MinX: v["min_x"].(float64),
+		MaxX: v["max_x"].(float64),
+		MinY: v["min_y"].(float64),
+		MaxY: v["max_y"].(float64),
+		CRS: gs.FeatureTypeCRS{
+			Class: v["crs_class"].(string),
+			Value: v["crs_value"].(string),
+		},
+	}
+}
+
+func flattenBoundingBox(box gs.BoundingBox) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"min_x":     box.MinX,
+			"max_x":     box.MaxX,
+			"min_y":     box.MinY,
+			"max_y":     box.MaxY,
+			"crs_class": box.CRS.Class,
+			"crs_value": box.CRS.Value,
+		},
+	}
+}
+
+// boundingBoxStateUpgrader migrates the flat bounding box attributes of the version 0 state into nested blocks
+func boundingBoxStateUpgrader(resource *schema.Resource, blocks ...string) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: 0,
+		Type:    boundingBoxV0Type(resource, blocks),
+		Upgrade: func(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+			for _, block := range blocks {
+				box := map[string]interface{}{}
+				for _, field := range boundingBoxFields {
+					key := fmt.Sprintf("%s_%s", block, field)
+					if value, ok := rawState[key]; ok {
+						if value != nil {
+							box[field] = value
+						}
+						delete(rawState, key)
+					}
+				}
+				if len(box) > 0 {
+					rawState[block] = []interface{}{box}
+				}
+			}
+
+			return rawState, nil
+		},
+	}
+}
+
+// boundingBoxV0Type returns the type of the version 0 state, where the bounding boxes were flat attributes
+func boundingBoxV0Type(resource *schema.Resource, blocks []string) cty.Type {
+	attributes := map[string]*schema.Schema{}
+	for key, value := range resource.Schema {
+		attributes[key] = value
+	}
+
+	for _, block := range blocks {
+		delete(attributes, block)
+		for _, field := range boundingBoxFields {
+			attribute := &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			}
+			if strings.HasPrefix(field, "crs_") {
+				attribute.Type = schema.TypeString
+			}
+			attributes[fmt.Sprintf("%s_%s", block, field)] = attribute
+		}
+	}
+
+	return (&schema.Resource{Schema: attributes}).CoreConfigSchema().ImpliedType()
+}
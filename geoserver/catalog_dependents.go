@@ -0,0 +1,316 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type restNamedLink struct {
+	Name string `json:"name"`
+}
+
+func (l *restNamedLink) UnmarshalJSON(data []byte) error {
+	// Default entries, e.g. the default style of a layer of a group, are serialized as strings
+	var name string
+	if json.Unmarshal(data, &name) == nil {
+		l.Name = name
+		return nil
+	}
+
+	type link restNamedLink
+	return json.Unmarshal(data, (*link)(l))
+}
+
+type restLayerStyles struct {
+	DefaultStyle *restNamedLink  `json:"defaultStyle"`
+	Styles       json.RawMessage `json:"styles"`
+}
+
+func forceDestroySchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: description,
+	}
+}
+
+// listCatalogNames returns the names of the objects of a Geoserver collection, e.g. {"dataStores": {"dataStore": [...]}}
+func listCatalogNames(client *RestClient, path string, collection string, item string) ([]string, error) {
+	var body map[string]json.RawMessage
+	err := client.GetJSON(path, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := decodeNamedLinks(body[collection], item)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode %s: %s", path, err)
+	}
+
+	var names []string
+	for _, link := range links {
+		names = append(names, link.Name)
+	}
+	return names, nil
+}
+
+// decodeNamedLinks decodes the items of a collection, which Geoserver serializes as an empty string when empty
+func decodeNamedLinks(raw json.RawMessage, item string) (restList[restNamedLink], error) {
+	var empty string
+	if len(raw) == 0 || json.Unmarshal(raw, &empty) == nil {
+		return nil, nil
+	}
+
+	var items map[string]restList[restNamedLink]
+	err := json.Unmarshal(raw, &items)
+	if err != nil {
+		return nil, err
+	}
+	return items[item], nil
+}
+
+// workspaceDependents lists the stores, styles and layer groups of a workspace
+func workspaceDependents(meta interface{}, workspaceName string) ([]string, error) {
+	client := meta.(*Config).RestClient()
+
+	collections := []struct {
+		kind       string
+		path       string
+		collection string
+		item       string
+	}{
+		{"datastore", "datastores", "dataStores", "dataStore"},
+		{"coverage store", "coveragestores", "coverageStores", "coverageStore"},
+		{"WMS store", "wmsstores", "wmsStores", "wmsStore"},
+		{"WMTS store", "wmtsstores", "wmtsStores", "wmtsStore"},
+		{"style", "styles", "styles", "style"},
+		{"layer group", "layergroups", "layerGroups", "layerGroup"},
+	}
+
+	var dependents []string
+	for _, collection := range collections {
+		names, err := listCatalogNames(client, fmt.Sprintf("/workspaces/%s/%s.json", workspaceName, collection.path), collection.collection, collection.item)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			dependents = append(dependents, fmt.Sprintf("%s %s", collection.kind, name))
+		}
+	}
+
+	return dependents, nil
+}
+
+// storeDependents lists the layers published from a store
+func storeDependents(meta interface{}, path string, collection string, item string) ([]string, error) {
+	client := meta.(*Config).RestClient()
+
+	names, err := listCatalogNames(client, fmt.Sprintf("%s.json", path), collection, item)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependents []string
+	for _, name := range names {
+		dependents = append(dependents, fmt.Sprintf("layer %s", name))
+	}
+	return dependents, nil
+}
+
+// styleDependent is a layer or a layer group using a style
+type styleDependent struct {
+	// label names the dependent in the messages, e.g. layer group ws:roads
+	label string
+	// path is the REST path of the dependent, whose JSON object is named root
+	path string
+	root string
+}
+
+// styleDependents lists the layers and layer groups using a style
+func styleDependents(meta interface{}, workspaceName string, styleName string) ([]string, error) {
+	dependents, err := findStyleDependents(meta, workspaceName, styleName)
+	if err != nil {
+		return nil, err
+	}
+
+	var labels []string
+	for _, dependent := range dependents {
+		labels = append(labels, dependent.label)
+	}
+	return labels, nil
+}
+
+// qualifiedStyleName returns the name of a style as referenced by the layers and layer groups
+func qualifiedStyleName(workspaceName string, styleName string) string {
+	if workspaceName == "" {
+		return styleName
+	}
+	return fmt.Sprintf("%s:%s", workspaceName, styleName)
+}
+
+// findStyleDependents finds the layers and layer groups using a style. A workspace style may only be used by
+// the layers and the layer groups of its workspace, a global style by the ones of any workspace.
+func findStyleDependents(meta interface{}, workspaceName string, styleName string) ([]styleDependent, error) {
+	client := meta.(*Config).RestClient()
+
+	qualifiedName := qualifiedStyleName(workspaceName, styleName)
+	layersPaths := []string{fmt.Sprintf("/workspaces/%s/layers", workspaceName)}
+	// The layer groups are named after their workspace only when a global style lists the ones of all the workspaces
+	type layerGroupsPath struct {
+		path   string
+		prefix string
+	}
+	layerGroupsPaths := []layerGroupsPath{{path: fmt.Sprintf("/workspaces/%s/layergroups", workspaceName)}}
+	if workspaceName == "" {
+		// The layers of all the workspaces are listed at once, each one being fetched to read its styles
+		layersPaths = []string{"/layers"}
+		layerGroupsPaths = []layerGroupsPath{{path: "/layergroups"}}
+
+		workspaces, err := listCatalogNames(client, "/workspaces.json", "workspaces", "workspace")
+		if err != nil {
+			return nil, err
+		}
+		for _, workspace := range workspaces {
+			layerGroupsPaths = append(layerGroupsPaths, layerGroupsPath{
+				path:   fmt.Sprintf("/workspaces/%s/layergroups", url.PathEscape(workspace)),
+				prefix: fmt.Sprintf("%s:", workspace),
+			})
+		}
+	}
+
+	usesStyle := func(styles restLayerStyles) (bool, error) {
+		links, err := decodeNamedLinks(styles.Styles, "style")
+		if err != nil {
+			return false, err
+		}
+		if styles.DefaultStyle != nil {
+			links = append(links, *styles.DefaultStyle)
+		}
+		for _, link := range links {
+			if link.Name == qualifiedName {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	var dependents []styleDependent
+
+	for _, layersPath := range layersPaths {
+		layers, err := listCatalogNames(client, fmt.Sprintf("%s.json", layersPath), "layers", "layer")
+		if err != nil {
+			return nil, err
+		}
+		for _, layer := range layers {
+			path := fmt.Sprintf("%s/%s", layersPath, url.PathEscape(layer))
+
+			var body struct {
+				Layer restLayerStyles `json:"layer"`
+			}
+			err = client.GetJSON(fmt.Sprintf("%s.json", path), &body)
+			if err != nil {
+				return nil, err
+			}
+			used, err := usesStyle(body.Layer)
+			if err != nil {
+				return nil, err
+			}
+			if used {
+				dependents = append(dependents, styleDependent{
+					label: fmt.Sprintf("layer %s", layer),
+					path:  path,
+					root:  "layer",
+				})
+			}
+		}
+	}
+
+	for _, layerGroupsPath := range layerGroupsPaths {
+		layerGroups, err := listCatalogNames(client, fmt.Sprintf("%s.json", layerGroupsPath.path), "layerGroups", "layerGroup")
+		if err != nil {
+			return nil, err
+		}
+		for _, layerGroup := range layerGroups {
+			path := fmt.Sprintf("%s/%s", layerGroupsPath.path, url.PathEscape(layerGroup))
+
+			var body struct {
+				LayerGroup restLayerStyles `json:"layerGroup"`
+			}
+			err = client.GetJSON(fmt.Sprintf("%s.json", path), &body)
+			if err != nil {
+				return nil, err
+			}
+			used, err := usesStyle(body.LayerGroup)
+			if err != nil {
+				return nil, err
+			}
+			if used {
+				dependents = append(dependents, styleDependent{
+					label: fmt.Sprintf("layer group %s%s", layerGroupsPath.prefix, layerGroup),
+					path:  path,
+					root:  "layerGroup",
+				})
+			}
+		}
+	}
+
+	return dependents, nil
+}
+
+// repointStyle makes a layer or a layer group use another style in place of the given one
+func repointStyle(meta interface{}, dependent styleDependent, oldName string, newName string) error {
+	client := meta.(*Config).RestClient()
+
+	var body map[string]map[string]interface{}
+	err := client.GetJSON(fmt.Sprintf("%s.json", dependent.path), &body)
+	if err != nil {
+		return err
+	}
+
+	object := body[dependent.root]
+	for _, key := range []string{"defaultStyle", "styles"} {
+		if value, ok := object[key]; ok {
+			object[key] = renameStyleLinks(value, oldName, newName)
+		}
+	}
+
+	return client.SendJSON(http.MethodPut, dependent.path, map[string]interface{}{
+		dependent.root: object,
+	})
+}
+
+// renameStyleLinks renames the links to a style, dropping their href which still points to the old style
+func renameStyleLinks(value interface{}, oldName string, newName string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if v["name"] == oldName {
+			v["name"] = newName
+			delete(v, "href")
+			return v
+		}
+		for key, nested := range v {
+			v[key] = renameStyleLinks(nested, oldName, newName)
+		}
+	case []interface{}:
+		for index, nested := range v {
+			v[index] = renameStyleLinks(nested, oldName, newName)
+		}
+	case string:
+		// Default entries, e.g. the styles of the layers of a group, may be serialized as strings
+		if v == oldName {
+			return newName
+		}
+	}
+	return value
+}
+
+// refuseDestroy builds the error returned when an object still has dependents and force_destroy is false
+func refuseDestroy(kind string, name string, dependents []string) error {
+	return fmt.Errorf("%s %s still has dependent objects: %s. Delete them first, or set force_destroy = true to delete them along with the %s", kind, name, strings.Join(dependents, ", "), kind)
+}
@@ -15,6 +15,7 @@ type Config struct {
 	Username           string
 	Password           string
 	InsecureSkipVerify bool
+	AdoptExisting      bool
 }
 
 func CreateConfig(URL string,
@@ -74,6 +75,28 @@ func (c *Config) GeoserverClient() *gs.Client {
 	return client
 }
 
+// RestClient creates a raw REST client for the Geoserver endpoints not covered by go-geoserver
+func (c *Config) RestClient() *RestClient {
+	tspt := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: c.InsecureSkipVerify,
+		},
+	}
+
+	client := &RestClient{
+		URL:      c.URL,
+		Username: c.Username,
+		Password: c.Password,
+		HTTPClient: &http.Client{
+			Transport: tspt,
+		},
+	}
+
+	log.Printf("[INFO] Geoserver REST Client configured")
+
+	return client
+}
+
 // Client creates a Geoserver client scoped to the global API
 func (c *Config) GwcClient() *gs.Client {
 	tspt := &http.Transport{
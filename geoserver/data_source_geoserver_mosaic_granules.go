@@ -0,0 +1,129 @@
+package geoserver
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceGeoserverMosaicGranules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGeoserverMosaicGranulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"workspace_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the workspace owning the ImageMosaic store.",
+			},
+			"coveragestore_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the ImageMosaic coverage store.",
+			},
+			"coverage_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the coverage whose index holds the granules.",
+			},
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CQL filter to apply on the index, e.g. `location LIKE '/data/2024%'`.",
+			},
+			"time_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "time",
+				Description: "Name of the index attribute holding the time of the granules. Default value is time.",
+			},
+			"elevation_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "elevation",
+				Description: "Name of the index attribute holding the elevation of the granules. Default value is elevation.",
+			},
+			"granules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Granules of the index matching the filter.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the granule in the index.",
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Location of the granule file.",
+						},
+						"time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value of the time attribute. Empty if the mosaic has no time dimension.",
+						},
+						"elevation": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value of the elevation attribute. Empty if the mosaic has no elevation dimension.",
+						},
+						"attributes": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "All the attributes of the granule in the index.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGeoserverMosaicGranulesRead(d *schema.ResourceData, meta interface{}) error {
+	workspaceName := d.Get("workspace_name").(string)
+	storeName := d.Get("coveragestore_name").(string)
+	coverageName := d.Get("coverage_name").(string)
+	filter := d.Get("filter").(string)
+
+	log.Printf("[INFO] Reading Geoserver Mosaic Granules of coverage `%s` in store `%s`", coverageName, storeName)
+
+	client := meta.(*Config).RestClient()
+
+	path := fmt.Sprintf("%s.json", mosaicGranulesPath(workspaceName, storeName, coverageName))
+	if filter != "" {
+		path = fmt.Sprintf("%s?filter=%s", path, url.QueryEscape(filter))
+	}
+
+	var index mosaicGranules
+	err := client.GetJSON(path, &index)
+	if err != nil {
+		return err
+	}
+
+	var granules []map[string]interface{}
+	for _, feature := range index.Features {
+		attributes := map[string]string{}
+		for key, value := range feature.Properties {
+			if value != nil {
+				attributes[key] = fmt.Sprint(value)
+			}
+		}
+		granules = append(granules, map[string]interface{}{
+			"id":         feature.ID,
+			"location":   attributes["location"],
+			"time":       attributes[d.Get("time_attribute").(string)],
+			"elevation":  attributes[d.Get("elevation_attribute").(string)],
+			"attributes": attributes,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", workspaceName, storeName, coverageName))
+	d.Set("granules", granules)
+
+	return nil
+}
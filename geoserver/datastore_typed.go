@@ -0,0 +1,295 @@
+package geoserver
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	gs "github.com/camptocamp/go-geoserver/client"
+)
+
+// datastoreParameter maps an attribute of a typed datastore resource onto a connection parameter
+type datastoreParameter struct {
+	attribute string
+	key       string
+	// writeOnly parameters are never read back, Geoserver returning them encrypted
+	writeOnly bool
+}
+
+// typedDatastore describes how a typed datastore resource maps onto the connection parameters of gs.Datastore.
+// The fixed parameters, e.g. dbtype, tell the kind of an existing datastore, or the first parameter when there are none.
+type typedDatastore struct {
+	kind       string
+	fixed      map[string]string
+	parameters []datastoreParameter
+	validate   func(d *schema.ResourceData) error
+}
+
+// typedDatastoreSchema adds the attributes shared by all the datastores to the attributes of a typed datastore
+func typedDatastoreSchema(attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	attributes["workspace_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the workspace owning the datastore. Used to compute the id of the resource.",
+	}
+	attributes["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the datastore. Used to compute the id of the resource.",
+	}
+	attributes["description"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "Description of the datastore. Default value is empty.",
+	}
+	attributes["enabled"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Mark the datastore as enabled. Default value is true.",
+	}
+	attributes["default"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Mark the datastore as default. Default value is false.",
+	}
+
+	attributes["adopt_existing"] = adoptExistingSchema()
+	attributes["force_destroy"] = forceDestroySchema("Delete the layers published from the datastore when destroying it. The destroy fails and lists them otherwise. Default value is false.")
+	attributes["validate_connection"] = validateConnectionSchema()
+
+	return attributes
+}
+
+// checkKind fails when an existing datastore is of another kind than the resource, which would
+// otherwise be read as a datastore of the resource kind and overwritten by the next update
+func (t *typedDatastore) checkKind(datastore *gs.Datastore) error {
+	connectionParameters := map[string]string{}
+	for _, entry := range datastore.ConnectionParameters {
+		connectionParameters[entry.Key] = entry.Value
+	}
+
+	if len(t.fixed) == 0 {
+		if _, ok := connectionParameters[t.parameters[0].key]; !ok {
+			return fmt.Errorf("datastore %s is not a %s datastore, connection parameter %q is missing", datastore.Name, t.kind, t.parameters[0].key)
+		}
+		return nil
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(t.fixed)) {
+		if connectionParameters[key] != t.fixed[key] {
+			return fmt.Errorf("datastore %s is not a %s datastore, connection parameter %q is %q instead of %q", datastore.Name, t.kind, key, connectionParameters[key], t.fixed[key])
+		}
+	}
+
+	return nil
+}
+
+// expand builds the datastore from the typed attributes. Empty strings are not sent, to keep the Geoserver defaults.
+func (t *typedDatastore) expand(d *schema.ResourceData) (*gs.Datastore, error) {
+	if t.validate != nil {
+		err := t.validate(d)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	connectionParameters := []*gs.DatastoreConnectionParameter{}
+	for key, value := range t.fixed {
+		connectionParameters = append(connectionParameters, &gs.DatastoreConnectionParameter{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	for _, parameter := range t.parameters {
+		value := fmt.Sprint(d.Get(parameter.attribute))
+		if value == "" {
+			continue
+		}
+		connectionParameters = append(connectionParameters, &gs.DatastoreConnectionParameter{
+			Key:   parameter.key,
+			Value: value,
+		})
+	}
+
+	return &gs.Datastore{
+		Name:                 d.Get("name").(string),
+		Description:          d.Get("description").(string),
+		Enabled:              d.Get("enabled").(bool),
+		Default:              d.Get("default").(bool),
+		ConnectionParameters: connectionParameters,
+	}, nil
+}
+
+func (t *typedDatastore) create(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Creating Geoserver %s Datastore: %s", t.kind, d.Get("name").(string))
+
+	client := meta.(*Config).GeoserverClient()
+
+	workspaceName := d.Get("workspace_name").(string)
+
+	if adoptExisting(d, meta) {
+		existing, err := client.GetDatastore(workspaceName, d.Get("name").(string))
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return err
+		}
+
+		if existing != nil {
+			err = t.checkKind(existing)
+			if err != nil {
+				return err
+			}
+
+			d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
+
+			log.Printf("[INFO] Adopting existing Geoserver %s Datastore: %s", t.kind, d.Id())
+
+			err = t.update(d, meta)
+			if err != nil {
+				return err
+			}
+
+			return t.read(d, meta)
+		}
+	}
+
+	datastore, err := t.expand(d)
+	if err != nil {
+		return err
+	}
+
+	err = client.CreateDatastore(workspaceName, datastore)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
+
+	err = validateStoreConnection(d, meta, fmt.Sprintf("/workspaces/%s/datastores/%s/featuretypes", workspaceName, d.Get("name").(string)))
+	if err != nil {
+		return err
+	}
+
+	return t.read(d, meta)
+}
+
+func (t *typedDatastore) read(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Geoserver %s Datastore: %s", t.kind, d.Id())
+
+	splittedID := strings.Split(d.Id(), "/")
+	workspaceName := splittedID[0]
+	datastoreName := splittedID[1]
+
+	client := meta.(*Config).GeoserverClient()
+
+	datastore, err := client.GetDatastore(workspaceName, datastoreName)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+
+	if datastore == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = t.checkKind(datastore)
+	if err != nil {
+		return err
+	}
+
+	d.Set("workspace_name", datastore.Workspace.Name)
+	d.Set("name", datastore.Name)
+	d.Set("description", datastore.Description)
+	d.Set("enabled", datastore.Enabled)
+	d.Set("default", datastore.Default)
+
+	connectionParameters := map[string]string{}
+	for _, entry := range datastore.ConnectionParameters {
+		connectionParameters[entry.Key] = entry.Value
+	}
+
+	for _, parameter := range t.parameters {
+		value, ok := connectionParameters[parameter.key]
+		if !ok || parameter.writeOnly {
+			continue
+		}
+
+		// The type of the attribute is given by the zero value of its schema
+		switch d.Get(parameter.attribute).(type) {
+		case int:
+			v, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("unable to read connection parameter %q: %s", parameter.key, err)
+			}
+			d.Set(parameter.attribute, v)
+		case bool:
+			v, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("unable to read connection parameter %q: %s", parameter.key, err)
+			}
+			d.Set(parameter.attribute, v)
+		default:
+			d.Set(parameter.attribute, value)
+		}
+	}
+
+	return nil
+}
+
+func (t *typedDatastore) update(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Geoserver %s Datastore: %s", t.kind, d.Id())
+
+	splittedID := strings.Split(d.Id(), "/")
+	workspaceName := splittedID[0]
+	datastoreName := splittedID[1]
+
+	client := meta.(*Config).GeoserverClient()
+
+	datastore, err := t.expand(d)
+	if err != nil {
+		return err
+	}
+
+	err = client.UpdateDatastore(workspaceName, datastoreName, datastore)
+	if err != nil {
+		return err
+	}
+
+	// The update is issued against the old name, the id follows the new one
+	d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
+
+	err = validateStoreConnection(d, meta, fmt.Sprintf("/workspaces/%s/datastores/%s/featuretypes", workspaceName, d.Get("name").(string)))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (t *typedDatastore) importState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	splittedID := strings.Split(d.Id(), "/")
+	workspaceName := splittedID[0]
+	datastoreName := splittedID[1]
+
+	d.SetId(d.Id())
+	d.Set("workspace_name", workspaceName)
+	d.Set("name", datastoreName)
+
+	log.Printf("[INFO] Importing Geoserver %s Datastore `%s` in workspace `%s`", t.kind, datastoreName, workspaceName)
+
+	err := t.read(d, meta)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
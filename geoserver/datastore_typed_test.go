@@ -0,0 +1,59 @@
+package geoserver
+
+import (
+	"testing"
+
+	gs "github.com/camptocamp/go-geoserver/client"
+)
+
+func TestTypedDatastoreCheckKind(t *testing.T) {
+	cases := []struct {
+		name       string
+		kind       *typedDatastore
+		parameters []*gs.DatastoreConnectionParameter
+		expected   bool
+	}{
+		{
+			"matching dbtype",
+			postgisDatastore,
+			[]*gs.DatastoreConnectionParameter{{Key: "dbtype", Value: "postgis"}, {Key: "host", Value: "db"}},
+			true,
+		},
+		{
+			"other dbtype",
+			postgisDatastore,
+			[]*gs.DatastoreConnectionParameter{{Key: "dbtype", Value: "geopkg"}, {Key: "database", Value: "file:data.gpkg"}},
+			false,
+		},
+		{
+			"missing dbtype",
+			postgisDatastore,
+			[]*gs.DatastoreConnectionParameter{{Key: "url", Value: "file:data/sf"}},
+			false,
+		},
+		{
+			"capabilities url",
+			wfsDatastore,
+			[]*gs.DatastoreConnectionParameter{{Key: "WFSDataStoreFactory:GET_CAPABILITIES_URL", Value: "https://example.com/wfs"}},
+			true,
+		},
+		{
+			"missing capabilities url",
+			wfsDatastore,
+			[]*gs.DatastoreConnectionParameter{{Key: "dbtype", Value: "postgis"}},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.kind.checkKind(&gs.Datastore{Name: "store", ConnectionParameters: c.parameters})
+			if c.expected && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if !c.expected && err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
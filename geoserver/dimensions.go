@@ -0,0 +1,196 @@
+package geoserver
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// dimensionMetadataKeys maps the dimension blocks to the metadata entries holding them
+var dimensionMetadataKeys = map[string]string{
+	"time_dimension":      "time",
+	"elevation_dimension": "elevation",
+}
+
+// dimensionInfo is the DimensionInfo object of the time and elevation metadata entries
+type dimensionInfo struct {
+	Enabled             bool                   `json:"enabled"`
+	Attribute           string                 `json:"attribute,omitempty"`
+	EndAttribute        string                 `json:"endAttribute,omitempty"`
+	Presentation        string                 `json:"presentation"`
+	Resolution          restText               `json:"resolution,omitempty"`
+	Units               string                 `json:"units,omitempty"`
+	UnitSymbol          string                 `json:"unitSymbol,omitempty"`
+	DefaultValue        *dimensionDefaultValue `json:"defaultValue,omitempty"`
+	NearestMatchEnabled bool                   `json:"nearestMatchEnabled"`
+	AcceptableInterval  string                 `json:"acceptableInterval,omitempty"`
+}
+
+type dimensionDefaultValue struct {
+	Strategy       string `json:"strategy"`
+	ReferenceValue string `json:"referenceValue,omitempty"`
+}
+
+func dimensionSchema(description string, forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		ForceNew:    forceNew,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					ForceNew:    forceNew,
+					Description: "Is the dimension enabled? Default value is true.",
+				},
+				"attribute": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: "Attribute holding the start value of the dimension. Not used by cascaded layers.",
+				},
+				"end_attribute": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: "Attribute holding the end value of the dimension, for features valid over a range.",
+				},
+				"presentation": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "LIST",
+					ForceNew:    forceNew,
+					Description: "How the dimension values are advertised in the capabilities. Authorized values are : LIST, CONTINUOUS_INTERVAL, DISCRETE_INTERVAL. Default value is LIST.",
+					ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+						v := val.(string)
+						allowed_values := []string{"LIST", "CONTINUOUS_INTERVAL", "DISCRETE_INTERVAL"}
+						if !slices.Contains(allowed_values, v) {
+							errs = append(errs, fmt.Errorf("%q must be one of this values %q, got: %q", key, strings.Join(allowed_values, ","), v))
+						}
+						return
+					},
+				},
+				"resolution": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: "Resolution of a DISCRETE_INTERVAL presentation. In milliseconds for time, in units for elevation.",
+				},
+				"units": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: "Units of the dimension, e.g. ISO8601 for time or EPSG:5030 for elevation.",
+				},
+				"unit_symbol": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: "Symbol of the units, e.g. m.",
+				},
+				"default_value_strategy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: "Strategy used to pick the value when the request does not provide one. Authorized values are : MINIMUM, MAXIMUM, NEAREST, FIXED, BUILT_IN. Geoserver picks the built-in strategy when empty.",
+					ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+						v := val.(string)
+						allowed_values := []string{"MINIMUM", "MAXIMUM", "NEAREST", "FIXED", "BUILT_IN"}
+						if !slices.Contains(allowed_values, v) {
+							errs = append(errs, fmt.Errorf("%q must be one of this values %q, got: %q", key, strings.Join(allowed_values, ","), v))
+						}
+						return
+					},
+				},
+				"default_value_reference": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: "Reference value of the NEAREST and FIXED strategies.",
+				},
+				"nearest_match_enabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					ForceNew:    forceNew,
+					Description: "Return the nearest available value when the requested one does not exist. Default value is false.",
+				},
+				"acceptable_interval": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: "Search range of the nearest match, e.g. PT1H or P1D/P0D.",
+				},
+			},
+		},
+	}
+}
+
+// expandDimensions encodes the dimension blocks as metadata entries, which must not be set in the metadata map too
+func expandDimensions(d *schema.ResourceData) ([]restMetadataEntry, error) {
+	var entries []restMetadataEntry
+	for _, block := range slices.Sorted(maps.Keys(dimensionMetadataKeys)) {
+		key := dimensionMetadataKeys[block]
+		dimensions := d.Get(block).([]interface{})
+		if len(dimensions) == 0 || dimensions[0] == nil {
+			continue
+		}
+		if _, ok := d.Get("metadata").(map[string]interface{})[key]; ok {
+			return nil, fmt.Errorf("the %s metadata entry is managed by %s, remove it from metadata", key, block)
+		}
+		v := dimensions[0].(map[string]interface{})
+
+		dimension := &dimensionInfo{
+			Enabled:             v["enabled"].(bool),
+			Attribute:           v["attribute"].(string),
+			EndAttribute:        v["end_attribute"].(string),
+			Presentation:        v["presentation"].(string),
+			Resolution:          restText(v["resolution"].(string)),
+			Units:               v["units"].(string),
+			UnitSymbol:          v["unit_symbol"].(string),
+			NearestMatchEnabled: v["nearest_match_enabled"].(bool),
+			AcceptableInterval:  v["acceptable_interval"].(string),
+		}
+		if v["default_value_strategy"].(string) != "" {
+			dimension.DefaultValue = &dimensionDefaultValue{
+				Strategy:       v["default_value_strategy"].(string),
+				ReferenceValue: v["default_value_reference"].(string),
+			}
+		}
+
+		entries = append(entries, restMetadataEntry{
+			Key:           key,
+			DimensionInfo: dimension,
+		})
+	}
+
+	return entries, nil
+}
+
+// flattenDimension decodes the DimensionInfo object of a metadata entry into a dimension block
+func flattenDimension(dimension *dimensionInfo) []map[string]interface{} {
+	block := map[string]interface{}{
+		"enabled":               dimension.Enabled,
+		"attribute":             dimension.Attribute,
+		"end_attribute":         dimension.EndAttribute,
+		"presentation":          dimension.Presentation,
+		"resolution":            string(dimension.Resolution),
+		"units":                 dimension.Units,
+		"unit_symbol":           dimension.UnitSymbol,
+		"nearest_match_enabled": dimension.NearestMatchEnabled,
+		"acceptable_interval":   dimension.AcceptableInterval,
+	}
+	if dimension.DefaultValue != nil {
+		block["default_value_strategy"] = dimension.DefaultValue.Strategy
+		block["default_value_reference"] = dimension.DefaultValue.ReferenceValue
+	}
+
+	return []map[string]interface{}{block}
+}
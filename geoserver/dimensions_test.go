@@ -0,0 +1,95 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestExpandDimensions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceGeoserverWmsLayer().Schema, map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"cachingEnabled": "false",
+		},
+		"time_dimension": []interface{}{
+			map[string]interface{}{
+				"attribute":              "date",
+				"presentation":           "DISCRETE_INTERVAL",
+				"resolution":             "86400000",
+				"units":                  "ISO8601",
+				"default_value_strategy": "MAXIMUM",
+			},
+		},
+	})
+
+	metadata, err := expandMetadata(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sent, err := json.Marshal(metadata)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"entry":[` +
+		`{"@key":"cachingEnabled","$":"false"},` +
+		`{"@key":"time","dimensionInfo":{"enabled":true,"attribute":"date","presentation":"DISCRETE_INTERVAL","resolution":"86400000","units":"ISO8601","defaultValue":{"strategy":"MAXIMUM"},"nearestMatchEnabled":false}}` +
+		`]}`
+	if string(sent) != expected {
+		t.Errorf("expected %s, got %s", expected, sent)
+	}
+}
+
+func TestExpandDimensionsDuplicateMetadata(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceGeoserverWmsLayer().Schema, map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"time": "true",
+		},
+		"time_dimension": []interface{}{
+			map[string]interface{}{
+				"presentation": "LIST",
+			},
+		},
+	})
+
+	_, err := expandMetadata(d)
+	if err == nil {
+		t.Error("expected an error for the time entry set twice")
+	}
+}
+
+func TestFlattenDimensions(t *testing.T) {
+	// A single entry is serialized as an object, and the resolution as a number
+	received := `{"wmsLayer":{"name":"radar","metadata":{"entry":` +
+		`{"@key":"elevation","dimensionInfo":{"enabled":true,"presentation":"LIST","resolution":100,"units":"EPSG:5030","unitSymbol":"m","nearestMatchEnabled":false}}` +
+		`}}}`
+
+	var body map[string]struct {
+		Metadata *restMetadata `json:"metadata"`
+	}
+	err := json.Unmarshal([]byte(received), &body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceGeoserverWmsLayer().Schema, map[string]interface{}{})
+	flattenMetadata(d, body["wmsLayer"].Metadata)
+
+	if len(d.Get("metadata").(map[string]interface{})) != 0 {
+		t.Errorf("expected no plain metadata entry, got %v", d.Get("metadata"))
+	}
+	if len(d.Get("time_dimension").([]interface{})) != 0 {
+		t.Errorf("expected no time dimension, got %v", d.Get("time_dimension"))
+	}
+	for attribute, expected := range map[string]string{
+		"elevation_dimension.0.resolution":  "100",
+		"elevation_dimension.0.units":       "EPSG:5030",
+		"elevation_dimension.0.unit_symbol": "m",
+	} {
+		if value := d.Get(attribute).(string); value != expected {
+			t.Errorf("expected %s to be %q, got %q", attribute, expected, value)
+		}
+	}
+}
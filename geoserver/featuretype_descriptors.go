@@ -0,0 +1,257 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// restList decodes the lists of the Geoserver JSON representation, which are
+// serialized as a single object when they hold only one element
+type restList[T any] []T
+
+func (l *restList[T]) UnmarshalJSON(data []byte) error {
+	var list []T
+	if err := json.Unmarshal(data, &list); err == nil {
+		*l = list
+		return nil
+	}
+
+	var single T
+	if err := json.Unmarshal(data, &single); err != nil {
+		return err
+	}
+	*l = restList[T]{single}
+	return nil
+}
+
+type restStrings struct {
+	Strings restList[string] `json:"string"`
+}
+
+func (s *restStrings) UnmarshalJSON(data []byte) error {
+	// Empty lists are serialized as an empty string
+	var empty string
+	if json.Unmarshal(data, &empty) == nil {
+		*s = restStrings{}
+		return nil
+	}
+
+	type list restStrings
+	return json.Unmarshal(data, (*list)(s))
+}
+
+type restMetadataLinks struct {
+	MetadataLinks restList[restMetadataLink] `json:"metadataLink"`
+}
+
+type restMetadataLink struct {
+	Type         string `json:"type"`
+	MetadataType string `json:"metadataType"`
+	Content      string `json:"content"`
+}
+
+type restDataLinks struct {
+	DataLinks restList[restDataLink] `json:"org.geoserver.catalog.impl.DataLinkInfoImpl"`
+}
+
+type restDataLink struct {
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+// featureTypeDescriptors holds the catalog descriptors of a feature type not exposed by go-geoserver
+type featureTypeDescriptors struct {
+	Enabled               bool                    `json:"enabled"`
+	Keywords              *restStrings            `json:"keywords"`
+	MetadataLinks         *restMetadataLinks      `json:"metadataLinks"`
+	DataLinks             *restDataLinks          `json:"dataLinks"`
+	ResponseSRS           *restStrings            `json:"responseSRS"`
+	ServiceConfiguration  bool                    `json:"serviceConfiguration"`
+	DisabledServices      *restStrings            `json:"disabledServices"`
+	MaxFeatures           int                     `json:"maxFeatures"`
+	NumDecimals           int                     `json:"numDecimals"`
+	CqlFilter             string                  `json:"cqlFilter"`
+	OverridingServiceSRS  bool                    `json:"overridingServiceSRS"`
+	SkipNumberMatched     bool                    `json:"skipNumberMatched"`
+	Advertised            *bool                   `json:"advertised"`
+	InternationalTitle    restInternationalString `json:"internationalTitle"`
+	InternationalAbstract restInternationalString `json:"internationalAbstract"`
+	Metadata              *restMetadata           `json:"metadata,omitempty"`
+}
+
+type featureTypeDescriptorsBody struct {
+	FeatureType featureTypeDescriptors `json:"featureType"`
+}
+
+func featureTypePath(workspaceName string, datastoreName string, featureTypeName string) string {
+	if datastoreName == "" {
+		return fmt.Sprintf("/workspaces/%s/featuretypes/%s", workspaceName, featureTypeName)
+	}
+	return fmt.Sprintf("/workspaces/%s/datastores/%s/featuretypes/%s", workspaceName, datastoreName, featureTypeName)
+}
+
+// encodeKeyword follows the Geoserver representation of a keyword: value\@language=fr\;\@vocabulary=theme\;
+func encodeKeyword(value string, language string, vocabulary string) string {
+	keyword := value
+	if language != "" {
+		keyword = fmt.Sprintf(`%s\@language=%s\;`, keyword, language)
+	}
+	if vocabulary != "" {
+		keyword = fmt.Sprintf(`%s\@vocabulary=%s\;`, keyword, vocabulary)
+	}
+	return keyword
+}
+
+func decodeKeyword(keyword string) (value string, language string, vocabulary string) {
+	parts := strings.Split(keyword, `\@`)
+	value = parts[0]
+	for _, part := range parts[1:] {
+		part = strings.TrimSuffix(part, `\;`)
+		switch {
+		case strings.HasPrefix(part, "language="):
+			language = strings.TrimPrefix(part, "language=")
+		case strings.HasPrefix(part, "vocabulary="):
+			vocabulary = strings.TrimPrefix(part, "vocabulary=")
+		}
+	}
+	return
+}
+
+func expandStrings(values []interface{}) *restStrings {
+	list := &restStrings{Strings: restList[string]{}}
+	for _, value := range values {
+		list.Strings = append(list.Strings, value.(string))
+	}
+	return list
+}
+
+func flattenStrings(values *restStrings) []string {
+	if values == nil {
+		return nil
+	}
+	return values.Strings
+}
+
+func expandFeatureTypeDescriptors(d *schema.ResourceData, metadata *restMetadata) *featureTypeDescriptors {
+	keywords := &restStrings{Strings: restList[string]{}}
+	for _, value := range d.Get("keywords").([]interface{}) {
+		v := value.(map[string]interface{})
+		keywords.Strings = append(keywords.Strings, encodeKeyword(v["value"].(string), v["language"].(string), v["vocabulary"].(string)))
+	}
+
+	advertised := d.Get("advertised").(bool)
+
+	metadataLinks := &restMetadataLinks{MetadataLinks: restList[restMetadataLink]{}}
+	for _, value := range d.Get("metadata_link").(*schema.Set).List() {
+		v := value.(map[string]interface{})
+		metadataLinks.MetadataLinks = append(metadataLinks.MetadataLinks, restMetadataLink{
+			Type:         v["type"].(string),
+			MetadataType: v["metadata_type"].(string),
+			Content:      v["content"].(string),
+		})
+	}
+
+	dataLinks := &restDataLinks{DataLinks: restList[restDataLink]{}}
+	for _, value := range d.Get("data_link").(*schema.Set).List() {
+		v := value.(map[string]interface{})
+		dataLinks.DataLinks = append(dataLinks.DataLinks, restDataLink{
+			Type:    v["type"].(string),
+			Content: v["content"].(string),
+		})
+	}
+
+	return &featureTypeDescriptors{
+		Enabled:               d.Get("enabled").(bool),
+		Keywords:              keywords,
+		MetadataLinks:         metadataLinks,
+		DataLinks:             dataLinks,
+		ResponseSRS:           expandStrings(d.Get("response_srs").([]interface{})),
+		ServiceConfiguration:  d.Get("service_configuration").(bool),
+		DisabledServices:      expandStrings(d.Get("disabled_services").([]interface{})),
+		MaxFeatures:           d.Get("max_features").(int),
+		NumDecimals:           d.Get("num_decimals").(int),
+		CqlFilter:             d.Get("cql_filter").(string),
+		OverridingServiceSRS:  d.Get("overriding_service_srs").(bool),
+		SkipNumberMatched:     d.Get("skip_number_matched").(bool),
+		Advertised:            &advertised,
+		InternationalTitle:    expandInternationalString(d.Get("international_title")),
+		InternationalAbstract: expandInternationalString(d.Get("international_abstract")),
+		Metadata:              metadata,
+	}
+}
+
+// updateFeatureTypeDescriptors applies the descriptors and the whole metadata with a partial update of the feature type,
+// which also computes the bounding boxes requested by recalculate
+func updateFeatureTypeDescriptors(d *schema.ResourceData, meta interface{}, workspaceName string, datastoreName string, featureTypeName string, metadata *restMetadata) error {
+	client := meta.(*Config).RestClient()
+
+	return client.SendJSON(http.MethodPut, recalculatePath(d, featureTypePath(workspaceName, datastoreName, featureTypeName)), &featureTypeDescriptorsBody{
+		FeatureType: *expandFeatureTypeDescriptors(d, metadata),
+	})
+}
+
+func readFeatureTypeDescriptors(d *schema.ResourceData, meta interface{}, workspaceName string, datastoreName string, featureTypeName string) error {
+	client := meta.(*Config).RestClient()
+
+	var body featureTypeDescriptorsBody
+	err := client.GetJSON(featureTypePath(workspaceName, datastoreName, featureTypeName), &body)
+	if err != nil {
+		return err
+	}
+	descriptors := body.FeatureType
+
+	var keywords []map[string]interface{}
+	if descriptors.Keywords != nil {
+		for _, keyword := range descriptors.Keywords.Strings {
+			value, language, vocabulary := decodeKeyword(keyword)
+			keywords = append(keywords, map[string]interface{}{
+				"value":      value,
+				"language":   language,
+				"vocabulary": vocabulary,
+			})
+		}
+	}
+	d.Set("keywords", keywords)
+
+	var metadataLinks []map[string]interface{}
+	if descriptors.MetadataLinks != nil {
+		for _, value := range descriptors.MetadataLinks.MetadataLinks {
+			metadataLinks = append(metadataLinks, map[string]interface{}{
+				"type":          value.Type,
+				"metadata_type": value.MetadataType,
+				"content":       value.Content,
+			})
+		}
+	}
+	d.Set("metadata_link", metadataLinks)
+
+	var dataLinks []map[string]interface{}
+	if descriptors.DataLinks != nil {
+		for _, value := range descriptors.DataLinks.DataLinks {
+			dataLinks = append(dataLinks, map[string]interface{}{
+				"type":    value.Type,
+				"content": value.Content,
+			})
+		}
+	}
+	d.Set("data_link", dataLinks)
+
+	d.Set("response_srs", flattenStrings(descriptors.ResponseSRS))
+	d.Set("service_configuration", descriptors.ServiceConfiguration)
+	d.Set("disabled_services", flattenStrings(descriptors.DisabledServices))
+	d.Set("max_features", descriptors.MaxFeatures)
+	d.Set("num_decimals", descriptors.NumDecimals)
+	d.Set("cql_filter", descriptors.CqlFilter)
+	d.Set("overriding_service_srs", descriptors.OverridingServiceSRS)
+	d.Set("skip_number_matched", descriptors.SkipNumberMatched)
+	// Geoserver leaves out the flag of the feature types advertised by default
+	d.Set("advertised", descriptors.Advertised == nil || *descriptors.Advertised)
+	d.Set("international_title", flattenInternationalString(descriptors.InternationalTitle))
+	d.Set("international_abstract", flattenInternationalString(descriptors.InternationalAbstract))
+
+	return flattenFeatureTypeMetadata(d, descriptors.Metadata)
+}
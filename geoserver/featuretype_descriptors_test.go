@@ -0,0 +1,41 @@
+package geoserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestReadFeatureTypeDescriptorsAdvertised(t *testing.T) {
+	cases := []struct {
+		name     string
+		received string
+		expected bool
+	}{
+		// Geoserver leaves out the flag of the feature types advertised by default
+		{"missing", `{"featureType":{"name":"roads"}}`, true},
+		{"advertised", `{"featureType":{"name":"roads","advertised":true}}`, true},
+		{"hidden", `{"featureType":{"name":"roads","advertised":false}}`, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(c.received))
+			}))
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceGeoserverFeatureType().Schema, map[string]interface{}{"advertised": !c.expected})
+			err := readFeatureTypeDescriptors(d, &Config{URL: server.URL}, "ws", "store", "roads")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if advertised := d.Get("advertised").(bool); advertised != c.expected {
+				t.Errorf("expected advertised to be %t, got %t", c.expected, advertised)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package geoserver
+
+import (
+	"testing"
+)
+
+func TestCrsEPSGCode(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{"code", "EPSG:2154", "EPSG:2154"},
+		{"geographic WKT", `GEOGCS["WGS 84", DATUM["World Geodetic System 1984", SPHEROID["WGS 84", 6378137.0, 298.257223563, AUTHORITY["EPSG","7030"]], AUTHORITY["EPSG","6326"]], PRIMEM["Greenwich", 0.0, AUTHORITY["EPSG","8901"]], UNIT["degree", 0.017453292519943295], AXIS["Geodetic longitude", EAST], AXIS["Geodetic latitude", NORTH], AUTHORITY["EPSG","4326"]]`, "EPSG:4326"},
+		{"projected WKT", `PROJCS["RGF93 / Lambert-93", GEOGCS["RGF93", DATUM["Reseau Geodesique Francais 1993", SPHEROID["GRS 1980", 6378137.0, 298.257222101, AUTHORITY["EPSG","7019"]], AUTHORITY["EPSG","6171"]], AUTHORITY["EPSG","4171"]], PROJECTION["Lambert_Conformal_Conic_2SP", AUTHORITY["EPSG","9802"]], UNIT["m", 1.0], AXIS["Easting", EAST], AXIS["Northing", NORTH], AUTHORITY["EPSG","2154"]]`, "EPSG:2154"},
+		{"WKT2", `PROJCRS["WGS 84 / Pseudo-Mercator", BASEGEOGCRS["WGS 84", ID["EPSG",4326]], ID["EPSG",3857]]`, "EPSG:3857"},
+		{"without authority", `LOCAL_CS["Wildcard 2D cartesian plane in metric unit"]`, ""},
+		{"empty", "", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if code := crsEPSGCode(c.value); code != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, code)
+			}
+		})
+	}
+}
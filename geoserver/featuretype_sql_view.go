@@ -0,0 +1,247 @@
+package geoserver
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// sqlViewMetadataKey is the metadata entry holding the definition of a SQL view
+const sqlViewMetadataKey = "JDBC_VIRTUAL_TABLE"
+
+// virtualTable is the VirtualTable object of the JDBC_VIRTUAL_TABLE metadata entry
+type virtualTable struct {
+	Name       string                          `json:"name"`
+	SQL        string                          `json:"sql"`
+	EscapeSQL  bool                            `json:"escapeSql"`
+	KeyColumns restList[string]                `json:"keyColumn,omitempty"`
+	Geometries restList[virtualTableGeometry]  `json:"geometry,omitempty"`
+	Parameters restList[virtualTableParameter] `json:"parameter,omitempty"`
+}
+
+type virtualTableGeometry struct {
+	Name string   `json:"name"`
+	Type string   `json:"type"`
+	SRID restText `json:"srid"`
+}
+
+type virtualTableParameter struct {
+	Name            string `json:"name"`
+	DefaultValue    string `json:"defaultValue,omitempty"`
+	RegexpValidator string `json:"regexpValidator,omitempty"`
+}
+
+func sqlViewSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "SQL view (virtual table) published by the feature type. Only supported by JDBC datastores. Stored in the JDBC_VIRTUAL_TABLE metadata entry.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the SQL view. Must match the native name of the feature type.",
+				},
+				"sql": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "SQL query defining the view. Parameters are referenced as %name%.",
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						return strings.TrimSpace(old) == strings.TrimSpace(new)
+					},
+				},
+				"escape_sql": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Escape special SQL characters in the parameter values. Default value is false.",
+				},
+				"geometry_column": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of the geometry column returned by the query.",
+				},
+				"geometry_type": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "Geometry",
+					Description: "Type of the geometry column. Authorized values are : Geometry, GeometryCollection, Point, MultiPoint, LineString, MultiLineString, Polygon, MultiPolygon. Default value is Geometry.",
+					ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+						v := val.(string)
+						if !slices.Contains(geometryTypes, v) {
+							errs = append(errs, fmt.Errorf("%q must be one of this values %q, got: %q", key, strings.Join(geometryTypes, ","), v))
+						}
+						return
+					},
+				},
+				"geometry_srid": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     -1,
+					Description: "SRID of the geometry column. Default value is -1 (unknown).",
+				},
+				"key_columns": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Columns identifying uniquely each feature returned by the query.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"parameter": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Parameters of the SQL query.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Name of the parameter.",
+							},
+							"default_value": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Value used when the parameter is not provided in the request.",
+							},
+							"regexp_validator": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     `^[\w\d\s]+$`,
+								Description: "Regular expression the parameter values must match. Default value is ^[\\w\\d\\s]+$.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandSqlView encodes the sql_view block as the VirtualTable of the JDBC_VIRTUAL_TABLE metadata entry, nil if not set.
+// The view must be named after the feature type and its entry must not be set in the metadata map too.
+func expandSqlView(d *schema.ResourceData) (*virtualTable, error) {
+	sqlViews := d.Get("sql_view").([]interface{})
+	if len(sqlViews) == 0 || sqlViews[0] == nil {
+		return nil, nil
+	}
+	v := sqlViews[0].(map[string]interface{})
+
+	if _, ok := d.Get("metadata").(map[string]interface{})[sqlViewMetadataKey]; ok {
+		return nil, fmt.Errorf("the %s metadata entry is managed by sql_view, remove it from metadata", sqlViewMetadataKey)
+	}
+	if v["name"].(string) != d.Get("native_name").(string) {
+		return nil, fmt.Errorf("the name of the SQL view %q must match the native name of the feature type %q", v["name"].(string), d.Get("native_name").(string))
+	}
+
+	view := &virtualTable{
+		Name:      v["name"].(string),
+		SQL:       v["sql"].(string),
+		EscapeSQL: v["escape_sql"].(bool),
+	}
+
+	for _, value := range v["key_columns"].([]interface{}) {
+		view.KeyColumns = append(view.KeyColumns, value.(string))
+	}
+
+	if v["geometry_column"].(string) != "" {
+		view.Geometries = append(view.Geometries, virtualTableGeometry{
+			Name: v["geometry_column"].(string),
+			Type: v["geometry_type"].(string),
+			SRID: restText(strconv.Itoa(v["geometry_srid"].(int))),
+		})
+	}
+
+	for _, value := range v["parameter"].([]interface{}) {
+		p := value.(map[string]interface{})
+		view.Parameters = append(view.Parameters, virtualTableParameter{
+			Name:            p["name"].(string),
+			DefaultValue:    p["default_value"].(string),
+			RegexpValidator: p["regexp_validator"].(string),
+		})
+	}
+
+	return view, nil
+}
+
+// flattenSqlView decodes the VirtualTable of a JDBC_VIRTUAL_TABLE metadata entry into a sql_view block
+func flattenSqlView(view *virtualTable) ([]map[string]interface{}, error) {
+	var parameters []map[string]interface{}
+	for _, value := range view.Parameters {
+		parameters = append(parameters, map[string]interface{}{
+			"name":             value.Name,
+			"default_value":    value.DefaultValue,
+			"regexp_validator": value.RegexpValidator,
+		})
+	}
+
+	sqlView := map[string]interface{}{
+		"name":          view.Name,
+		"sql":           view.SQL,
+		"escape_sql":    view.EscapeSQL,
+		"key_columns":   []string(view.KeyColumns),
+		"parameter":     parameters,
+		"geometry_type": "Geometry",
+		"geometry_srid": -1,
+	}
+	if len(view.Geometries) > 0 {
+		srid, err := strconv.Atoi(string(view.Geometries[0].SRID))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode the SRID of the %s metadata entry: %s", sqlViewMetadataKey, err)
+		}
+		sqlView["geometry_column"] = view.Geometries[0].Name
+		sqlView["geometry_type"] = view.Geometries[0].Type
+		sqlView["geometry_srid"] = srid
+	}
+
+	return []map[string]interface{}{sqlView}, nil
+}
+
+// expandFeatureTypeMetadata adds the SQL view to the metadata map and the dimensions of a feature type
+func expandFeatureTypeMetadata(d *schema.ResourceData) (*restMetadata, error) {
+	metadata, err := expandMetadata(d)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlView, err := expandSqlView(d)
+	if err != nil {
+		return nil, err
+	}
+	if sqlView != nil {
+		metadata.Entries = append(metadata.Entries, restMetadataEntry{
+			Key:          sqlViewMetadataKey,
+			VirtualTable: sqlView,
+		})
+	}
+
+	return metadata, nil
+}
+
+// flattenFeatureTypeMetadata sets the metadata map, the dimensions and the SQL view of a feature type
+func flattenFeatureTypeMetadata(d *schema.ResourceData, metadata *restMetadata) error {
+	flattenMetadata(d, metadata)
+
+	var sqlView []map[string]interface{}
+	if metadata != nil {
+		for _, entry := range metadata.Entries {
+			if entry.VirtualTable == nil {
+				continue
+			}
+
+			var err error
+			sqlView, err = flattenSqlView(entry.VirtualTable)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	d.Set("sql_view", sqlView)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestExpandSqlView(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected bool
+	}{
+		{
+			"valid",
+			map[string]interface{}{
+				"native_name": "roads_view",
+				"sql_view":    []interface{}{map[string]interface{}{"name": "roads_view", "sql": "select * from roads"}},
+			},
+			true,
+		},
+		{
+			"name not matching the native name",
+			map[string]interface{}{
+				"native_name": "roads",
+				"sql_view":    []interface{}{map[string]interface{}{"name": "roads_view", "sql": "select * from roads"}},
+			},
+			false,
+		},
+		{
+			"entry set in metadata",
+			map[string]interface{}{
+				"native_name": "roads_view",
+				"metadata":    map[string]interface{}{sqlViewMetadataKey: "roads_view"},
+				"sql_view":    []interface{}{map[string]interface{}{"name": "roads_view", "sql": "select * from roads"}},
+			},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceGeoserverFeatureType().Schema, c.raw)
+
+			view, err := expandSqlView(d)
+			if c.expected && (err != nil || view == nil) {
+				t.Errorf("expected a virtual table, got %v, %v", view, err)
+			}
+			if !c.expected && err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestExpandFeatureTypeMetadataSqlView(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceGeoserverFeatureType().Schema, map[string]interface{}{
+		"native_name": "roads_view",
+		"sql_view": []interface{}{
+			map[string]interface{}{
+				"name":            "roads_view",
+				"sql":             "select * from roads where type = '%type%'",
+				"geometry_column": "geom",
+				"geometry_type":   "LineString",
+				"geometry_srid":   2056,
+				"key_columns":     []interface{}{"id"},
+				"parameter": []interface{}{
+					map[string]interface{}{
+						"name":          "type",
+						"default_value": "highway",
+					},
+				},
+			},
+		},
+	})
+
+	metadata, err := expandFeatureTypeMetadata(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sent, err := json.Marshal(metadata)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"entry":[{"@key":"JDBC_VIRTUAL_TABLE","virtualTable":{` +
+		`"name":"roads_view","sql":"select * from roads where type = '%type%'","escapeSql":false,` +
+		`"keyColumn":["id"],` +
+		`"geometry":[{"name":"geom","type":"LineString","srid":"2056"}],` +
+		`"parameter":[{"name":"type","defaultValue":"highway","regexpValidator":"^[\\w\\d\\s]+$"}]` +
+		`}}]}`
+	if string(sent) != expected {
+		t.Errorf("expected %s, got %s", expected, sent)
+	}
+}
+
+func TestFlattenFeatureTypeMetadataSqlView(t *testing.T) {
+	// The single key column, geometry and parameter are serialized as objects
+	received := `{"entry":[` +
+		`{"@key":"cachingEnabled","$":"false"},` +
+		`{"@key":"JDBC_VIRTUAL_TABLE","virtualTable":{"name":"roads_view","sql":"select * from roads","escapeSql":false,` +
+		`"keyColumn":"id","geometry":{"name":"geom","type":"LineString","srid":2056},"parameter":{"name":"type","defaultValue":"highway"}}}` +
+		`]}`
+
+	var metadata restMetadata
+	err := json.Unmarshal([]byte(received), &metadata)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceGeoserverFeatureType().Schema, map[string]interface{}{})
+	err = flattenFeatureTypeMetadata(d, &metadata)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if values := d.Get("metadata").(map[string]interface{}); len(values) != 1 || values["cachingEnabled"] != "false" {
+		t.Errorf("expected only the cachingEnabled entry in metadata, got %v", values)
+	}
+	for attribute, expected := range map[string]interface{}{
+		"sql_view.0.name":                      "roads_view",
+		"sql_view.0.key_columns.0":             "id",
+		"sql_view.0.geometry_column":           "geom",
+		"sql_view.0.geometry_type":             "LineString",
+		"sql_view.0.geometry_srid":             2056,
+		"sql_view.0.parameter.0.name":          "type",
+		"sql_view.0.parameter.0.default_value": "highway",
+	} {
+		if value := d.Get(attribute); value != expected {
+			t.Errorf("expected %s to be %v, got %v", attribute, expected, value)
+		}
+	}
+}
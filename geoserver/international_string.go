@@ -0,0 +1,159 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// restInternationalString is a text translated in several languages, serialized as {"en": "...", "fr": "..."}
+type restInternationalString map[string]string
+
+func (s *restInternationalString) UnmarshalJSON(data []byte) error {
+	// Geoserver serializes a text without translation as an empty string
+	var text string
+	if json.Unmarshal(data, &text) == nil {
+		*s = restInternationalString{}
+		return nil
+	}
+
+	var translations map[string]string
+	err := json.Unmarshal(data, &translations)
+	if err != nil {
+		return err
+	}
+
+	*s = translations
+	return nil
+}
+
+func internationalStringSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: description,
+	}
+}
+
+// expandInternationalString never returns nil, an empty map clearing the translations on the Geoserver side
+func expandInternationalString(value interface{}) restInternationalString {
+	translations := restInternationalString{}
+	if value == nil {
+		return translations
+	}
+
+	for language, text := range value.(map[string]interface{}) {
+		translations[language] = text.(string)
+	}
+	return translations
+}
+
+func flattenInternationalString(translations restInternationalString) map[string]interface{} {
+	value := map[string]interface{}{}
+	for language, text := range translations {
+		value[language] = text
+	}
+	return value
+}
+
+// restInternationalDescriptors holds the translated descriptors of a service or a layer group not exposed by go-geoserver
+type restInternationalDescriptors struct {
+	InternationalTitle    restInternationalString `json:"internationalTitle"`
+	InternationalAbstract restInternationalString `json:"internationalAbstract"`
+	Keywords              *restStrings            `json:"keywords"`
+}
+
+func internationalKeywordsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"value": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The keyword.",
+				},
+				"language": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Language of the keyword, e.g. en.",
+				},
+				"vocabulary": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Vocabulary (thesaurus) the keyword belongs to.",
+				},
+			},
+		},
+	}
+}
+
+// expandInternationalDescriptors sends the plain keywords followed by the international ones, Geoserver keeping both in the same list
+func expandInternationalDescriptors(d *schema.ResourceData) *restInternationalDescriptors {
+	keywords := expandStrings(d.Get("keywords").([]interface{}))
+	for _, value := range d.Get("international_keywords").([]interface{}) {
+		v := value.(map[string]interface{})
+		keywords.Strings = append(keywords.Strings, encodeKeyword(v["value"].(string), v["language"].(string), v["vocabulary"].(string)))
+	}
+
+	return &restInternationalDescriptors{
+		InternationalTitle:    expandInternationalString(d.Get("international_title")),
+		InternationalAbstract: expandInternationalString(d.Get("international_abstract")),
+		Keywords:              keywords,
+	}
+}
+
+func flattenInternationalDescriptors(d *schema.ResourceData, descriptors *restInternationalDescriptors) {
+	d.Set("international_title", flattenInternationalString(descriptors.InternationalTitle))
+	d.Set("international_abstract", flattenInternationalString(descriptors.InternationalAbstract))
+
+	var keywords []string
+	var internationalKeywords []map[string]interface{}
+	if descriptors.Keywords != nil {
+		for _, keyword := range descriptors.Keywords.Strings {
+			value, language, vocabulary := decodeKeyword(keyword)
+			if language == "" {
+				keywords = append(keywords, keyword)
+				continue
+			}
+			internationalKeywords = append(internationalKeywords, map[string]interface{}{
+				"value":      value,
+				"language":   language,
+				"vocabulary": vocabulary,
+			})
+		}
+	}
+	d.Set("keywords", keywords)
+	d.Set("international_keywords", internationalKeywords)
+}
+
+// updateInternationalDescriptors merges the translated descriptors in the object at path, whose JSON root is root
+func updateInternationalDescriptors(d *schema.ResourceData, meta interface{}, path string, root string) error {
+	client := meta.(*Config).RestClient()
+
+	return client.MergeJSON(path, map[string]interface{}{
+		root: expandInternationalDescriptors(d),
+	})
+}
+
+func readInternationalDescriptors(d *schema.ResourceData, meta interface{}, path string, root string) error {
+	client := meta.(*Config).RestClient()
+
+	var body map[string]*restInternationalDescriptors
+	err := client.GetJSON(fmt.Sprintf("%s.json", path), &body)
+	if err != nil {
+		return err
+	}
+
+	descriptors, ok := body[root]
+	if !ok || descriptors == nil {
+		return fmt.Errorf("unable to find %s in the representation of %s", root, path)
+	}
+	flattenInternationalDescriptors(d, descriptors)
+
+	return nil
+}
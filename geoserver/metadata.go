@@ -0,0 +1,127 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// restMetadata is the metadata map of a catalog resource. Geoserver serializes the plain values as
+// {"@key": ..., "$": ...} entries, and the structured ones as an object named after their class.
+type restMetadata struct {
+	Entries restList[restMetadataEntry] `json:"entry"`
+}
+
+func (m *restMetadata) UnmarshalJSON(data []byte) error {
+	// An empty map is serialized as an empty string
+	var empty string
+	if json.Unmarshal(data, &empty) == nil {
+		*m = restMetadata{}
+		return nil
+	}
+
+	type entries restMetadata
+	return json.Unmarshal(data, (*entries)(m))
+}
+
+type restMetadataEntry struct {
+	Key           string         `json:"@key"`
+	Value         *restText      `json:"$,omitempty"`
+	DimensionInfo *dimensionInfo `json:"dimensionInfo,omitempty"`
+	VirtualTable  *virtualTable  `json:"virtualTable,omitempty"`
+}
+
+// restText is a plain value, which Geoserver may serialize as a JSON number or boolean
+type restText string
+
+func (t *restText) UnmarshalJSON(data []byte) error {
+	var text string
+	if json.Unmarshal(data, &text) == nil {
+		*t = restText(text)
+		return nil
+	}
+
+	*t = restText(strings.TrimSpace(string(data)))
+	return nil
+}
+
+// expandMetadata encodes the metadata map and the dimension blocks of a resource
+func expandMetadata(d *schema.ResourceData) (*restMetadata, error) {
+	metadata := &restMetadata{Entries: restList[restMetadataEntry]{}}
+
+	values := d.Get("metadata").(map[string]interface{})
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		value := restText(values[key].(string))
+		metadata.Entries = append(metadata.Entries, restMetadataEntry{
+			Key:   key,
+			Value: &value,
+		})
+	}
+
+	dimensions, err := expandDimensions(d)
+	if err != nil {
+		return nil, err
+	}
+	metadata.Entries = append(metadata.Entries, dimensions...)
+
+	return metadata, nil
+}
+
+// flattenMetadata sets the metadata map and the dimension blocks of a resource. The other structured
+// entries are managed by dedicated blocks and left out.
+func flattenMetadata(d *schema.ResourceData, metadata *restMetadata) {
+	values := map[string]interface{}{}
+	dimensions := map[string][]map[string]interface{}{}
+	if metadata != nil {
+		for _, entry := range metadata.Entries {
+			if entry.DimensionInfo != nil {
+				for block, key := range dimensionMetadataKeys {
+					if key == entry.Key {
+						dimensions[block] = flattenDimension(entry.DimensionInfo)
+					}
+				}
+				continue
+			}
+			if entry.Value != nil {
+				values[entry.Key] = string(*entry.Value)
+			}
+		}
+	}
+
+	d.Set("metadata", values)
+	for block := range dimensionMetadataKeys {
+		d.Set(block, dimensions[block])
+	}
+}
+
+// updateMetadata sends the metadata of a resource, with an update only carrying its name and its enabled flag besides
+func updateMetadata(d *schema.ResourceData, meta interface{}, path string, root string, metadata *restMetadata) error {
+	client := meta.(*Config).RestClient()
+
+	return client.SendJSON(http.MethodPut, path, map[string]interface{}{
+		root: map[string]interface{}{
+			"name":     d.Get("name").(string),
+			"enabled":  d.Get("enabled").(bool),
+			"metadata": metadata,
+		},
+	})
+}
+
+// readMetadata fetches the metadata of a resource, whose object is named root
+func readMetadata(meta interface{}, path string, root string) (*restMetadata, error) {
+	client := meta.(*Config).RestClient()
+
+	var body map[string]struct {
+		Metadata *restMetadata `json:"metadata"`
+	}
+	err := client.GetJSON(path, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body[root].Metadata, nil
+}
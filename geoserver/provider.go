@@ -39,30 +39,59 @@ func Provider() terraform.ResourceProvider {
 				Default:     false,
 				Description: descriptions["insecure"],
 			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["adopt_existing"],
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"geoserver_workspace":          resourceGeoserverWorkspace(),
-			"geoserver_datastore":          resourceGeoserverDatastore(),
-			"geoserver_featuretype":        resourceGeoserverFeatureType(),
-			"geoserver_style":              resourceGeoserverStyle(),
-			"geoserver_layergroup":         resourceGeoserverLayerGroup(),
-			"geoserver_resource":           resourceGeoserverResource(),
-			"geoserver_gwc_S3_blobstore":   resourceGwcS3Blobstore(),
-			"geoserver_gwc_file_blobstore": resourceGwcFileBlobstore(),
-			"geoserver_gwc_gridset":        resourceGwcGridset(),
-			"geoserver_gwc_wms_layer":      resourceGwcWmsLayer(),
-			"geoserver_gwc_disk_quota":     resourceGwcDiskQuota(),
-			"geoserver_wms_store":          resourceGeoserverWmsStore(),
-			"geoserver_wms_layer":          resourceGeoserverWmsLayer(),
-			"geoserver_url_check":          resourceGeoserverUrlCheck(),
-			"geoserver_service_wms":        resourceGeoServerServiceWms(),
-			"geoserver_wmts_store":         resourceGeoserverWmtsStore(),
-			"geoserver_wmts_layer":         resourceGeoserverWmtsLayer(),
-			"geoserver_user":               resourceGeoserverUser(),
+			"geoserver_workspace":                     resourceGeoserverWorkspace(),
+			"geoserver_workspace_settings":            resourceGeoserverWorkspaceSettings(),
+			"geoserver_datastore":                     resourceGeoserverDatastore(),
+			"geoserver_datastore_postgis":             resourceGeoserverDatastorePostgis(),
+			"geoserver_datastore_geopackage":          resourceGeoserverDatastoreGeopackage(),
+			"geoserver_datastore_shapefile_directory": resourceGeoserverDatastoreShapefileDirectory(),
+			"geoserver_datastore_wfs":                 resourceGeoserverDatastoreWfs(),
+			"geoserver_featuretype":                   resourceGeoserverFeatureType(),
+			"geoserver_style":                         resourceGeoserverStyle(),
+			"geoserver_layergroup":                    resourceGeoserverLayerGroup(),
+			"geoserver_resource":                      resourceGeoserverResource(),
+			"geoserver_gwc_S3_blobstore":              resourceGwcS3Blobstore(),
+			"geoserver_gwc_file_blobstore":            resourceGwcFileBlobstore(),
+			"geoserver_gwc_gridset":                   resourceGwcGridset(),
+			"geoserver_gwc_wms_layer":                 resourceGwcWmsLayer(),
+			"geoserver_gwc_disk_quota":                resourceGwcDiskQuota(),
+			"geoserver_wms_store":                     resourceGeoserverWmsStore(),
+			"geoserver_wms_layer":                     resourceGeoserverWmsLayer(),
+			"geoserver_url_check":                     resourceGeoserverUrlCheck(),
+			"geoserver_service_wms":                   resourceGeoServerServiceWms(),
+			"geoserver_service_wfs":                   resourceGeoServerServiceWfs(),
+			"geoserver_service_wcs":                   resourceGeoServerServiceWcs(),
+			"geoserver_service_wmts":                  resourceGeoServerServiceWmts(),
+			"geoserver_service_wps":                   resourceGeoServerServiceWps(),
+			"geoserver_service_ogcapi_features":       resourceGeoServerServiceOgcapiFeatures(),
+			"geoserver_service_ogcapi_tiles":          resourceGeoServerServiceOgcapiTiles(),
+			"geoserver_service_ogcapi_styles":         resourceGeoServerServiceOgcapiStyles(),
+			"geoserver_global_settings":               resourceGeoserverGlobalSettings(),
+			"geoserver_contact":                       resourceGeoserverContact(),
+			"geoserver_logging":                       resourceGeoserverLogging(),
+			"geoserver_wmts_store":                    resourceGeoserverWmtsStore(),
+			"geoserver_wmts_layer":                    resourceGeoserverWmtsLayer(),
+			"geoserver_user":                          resourceGeoserverUser(),
+			"geoserver_role":                          resourceGeoserverRole(),
+			"geoserver_user_role":                     resourceGeoserverUserRole(),
+			"geoserver_group_role":                    resourceGeoserverGroupRole(),
+			"geoserver_user_group":                    resourceGeoserverUserGroup(),
+			"geoserver_user_group_membership":         resourceGeoserverUserGroupMembership(),
+			"geoserver_mosaic_granule":                resourceGeoserverMosaicGranule(),
 		},
 
-		DataSourcesMap: map[string]*schema.Resource{},
+		DataSourcesMap: map[string]*schema.Resource{
+			"geoserver_mosaic_granules": dataSourceGeoserverMosaicGranules(),
+		},
 
 		ConfigureFunc: providerConfigure,
 	}
@@ -77,6 +106,8 @@ func init() {
 		"username": "Username to use for connection",
 		"password": "Password to use for connection",
 		"insecure": "Whether to verify the server's SSL certificate",
+		"adopt_existing": "Whether Create adopts the workspaces, stores and styles already existing in Geoserver instead of failing. " +
+			"The adopted objects are updated to match the configuration.",
 	}
 }
 
@@ -87,5 +118,6 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Username:           d.Get("username").(string),
 		Password:           d.Get("password").(string),
 		InsecureSkipVerify: d.Get("insecure").(bool),
+		AdoptExisting:      d.Get("adopt_existing").(bool),
 	}, nil
 }
@@ -0,0 +1,36 @@
+package geoserver
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// The acceptance tests run with make testacc against a Geoserver holding the default sample data,
+// configured through the GEOSERVER_URL, GEOWEBCACHE_URL, GEOSERVER_USERNAME and GEOSERVER_PASSWORD variables
+var testAccProvider *schema.Provider
+var testAccProviders map[string]terraform.ResourceProvider
+
+func init() {
+	testAccProvider = Provider().(*schema.Provider)
+	testAccProviders = map[string]terraform.ResourceProvider{
+		"geoserver": testAccProvider,
+	}
+}
+
+func TestProvider(t *testing.T) {
+	err := Provider().(*schema.Provider).InternalValidate()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	for _, variable := range []string{"GEOSERVER_URL", "GEOWEBCACHE_URL", "GEOSERVER_USERNAME", "GEOSERVER_PASSWORD"} {
+		if os.Getenv(variable) == "" {
+			t.Fatalf("%s must be set for the acceptance tests", variable)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package geoserver
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type contactBody struct {
+	Contact *restContact `json:"contact"`
+}
+
+type workspaceContactBody struct {
+	Settings contactBody `json:"settings"`
+}
+
+func resourceGeoserverContact() *schema.Resource {
+	attributes := contactAttributes()
+	attributes["workspace_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Name of the workspace owning the contact. The workspace must have local settings, see geoserver_workspace_settings, whose contact block must then be left out. The global contact is managed when empty.",
+	}
+
+	return &schema.Resource{
+		Description: "Manage the contact information advertised in the capabilities documents, globally or for a workspace. The contact is a singleton so Create is similar to Update and Delete has no effect.",
+		Create:      resourceGeoserverContactCreate,
+		Read:        resourceGeoserverContactRead,
+		Update:      resourceGeoserverContactUpdate,
+		Delete:      resourceGeoserverContactDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGeoserverContactImport,
+		},
+
+		Schema: attributes,
+	}
+}
+
+func resourceGeoserverContactCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Configuring Geoserver Contact")
+
+	workspaceName := d.Get("workspace_name").(string)
+
+	if workspaceName == "" {
+		d.SetId("contact")
+	} else {
+		d.SetId(fmt.Sprintf("contact/%s", workspaceName))
+	}
+
+	err := resourceGeoserverContactUpdate(d, meta)
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceGeoserverContactRead(d, meta)
+}
+
+func resourceGeoserverContactRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Geoserver Contact: %s", d.Id())
+
+	var workspaceName string
+	if strings.Contains(d.Id(), "/") {
+		splittedID := strings.Split(d.Id(), "/")
+		workspaceName = splittedID[1]
+	}
+
+	client := meta.(*Config).RestClient()
+
+	var contact *restContact
+	if workspaceName == "" {
+		var body contactBody
+		err := client.GetJSON("/settings/contact.json", &body)
+		if err != nil {
+			return err
+		}
+		contact = body.Contact
+	} else {
+		var body workspaceSettingsBody
+		err := client.GetJSON(fmt.Sprintf("/workspaces/%s/settings.json", workspaceName), &body)
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return err
+		}
+
+		// Geoserver answers blank settings, without workspace, when the workspace has no local settings
+		if err != nil || body.Settings.Workspace == nil {
+			d.SetId("")
+			return nil
+		}
+		contact = body.Settings.Contact
+	}
+
+	if contact == nil {
+		contact = &restContact{}
+	}
+
+	d.Set("workspace_name", workspaceName)
+	for key, value := range flattenContact(contact) {
+		d.Set(key, value)
+	}
+
+	return nil
+}
+
+func resourceGeoserverContactUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Geoserver Contact: %s", d.Id())
+
+	client := meta.(*Config).RestClient()
+
+	workspaceName := d.Get("workspace_name").(string)
+	contact := expandContact(d.Get)
+
+	if workspaceName == "" {
+		return client.SendJSON(http.MethodPut, "/settings/contact", &contactBody{Contact: contact})
+	}
+
+	// The other local settings of the workspace are kept as they are
+	return client.MergeJSON(fmt.Sprintf("/workspaces/%s/settings", workspaceName), &workspaceContactBody{
+		Settings: contactBody{Contact: contact},
+	})
+}
+
+func resourceGeoserverContactDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Contact cannot be deleted. Skipping action")
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceGeoserverContactImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var workspaceName string
+	if strings.Contains(d.Id(), "/") {
+		splittedID := strings.Split(d.Id(), "/")
+		workspaceName = splittedID[1]
+	}
+	d.SetId(d.Id())
+	d.Set("workspace_name", workspaceName)
+
+	log.Printf("[INFO] Importing Geoserver Contact: %s", d.Id())
+
+	err := resourceGeoserverContactRead(d, meta)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -1,8 +1,12 @@
 package geoserver
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -19,6 +23,7 @@ func resourceGeoserverDatastore() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceGeoserverDatastoreImport,
 		},
+		CustomizeDiff: resourceGeoserverDatastoreCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"workspace_name": {
@@ -54,7 +59,18 @@ func resourceGeoserverDatastore() *schema.Resource {
 				Type:        schema.TypeMap,
 				Required:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
-				Description: "Datastore parameters. Match the parameters as defined in the REST API.",
+				Description: "Datastore parameters. Match the parameters as defined in the REST API. Secrets like passwd belong to sensitive_connection_params: Geoserver returns them encrypted, which would show as a change here.",
+			},
+			"adopt_existing":      adoptExistingSchema(),
+			"force_destroy":       forceDestroySchema("Delete the layers published from the datastore when destroying it. The destroy fails and lists them otherwise. Default value is false."),
+			"validate_connection": validateConnectionSchema(),
+			"sensitive_connection_params": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Sensitive:        true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressSensitiveConnectionParameterDiff,
+				Description:      "Datastore parameters holding secrets, e.g. passwd. Merged with connection_params when sent to Geoserver, but never read back: Geoserver returns them encrypted, so only the changes of the configuration are detected. The state only keeps a SHA-256 digest of the values. A key must not be set in both connection_params and sensitive_connection_params.",
 			},
 		},
 	}
@@ -63,17 +79,34 @@ func resourceGeoserverDatastore() *schema.Resource {
 func resourceGeoserverDatastoreCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Creating Geoserver Datastore: %s", d.Id())
 
-	connectionParameters := []*gs.DatastoreConnectionParameter{}
-	for key, value := range d.Get("connection_params").(map[string]interface{}) {
-		connectionParameters = append(connectionParameters, &gs.DatastoreConnectionParameter{
-			Key:   key,
-			Value: value.(string),
-		})
+	connectionParameters, err := expandDatastoreConnectionParameters(d, nil)
+	if err != nil {
+		return err
 	}
 
 	client := meta.(*Config).GeoserverClient()
 
 	workspaceName := d.Get("workspace_name").(string)
+
+	if adoptExisting(d, meta) {
+		existing, err := client.GetDatastore(workspaceName, d.Get("name").(string))
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return err
+		}
+
+		if existing != nil {
+			d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
+
+			log.Printf("[INFO] Adopting existing Geoserver Datastore: %s", d.Id())
+
+			err = resourceGeoserverDatastoreUpdate(d, meta)
+			if err != nil {
+				return err
+			}
+
+			return resourceGeoserverDatastoreRead(d, meta)
+		}
+	}
 	datastore := &gs.Datastore{
 		Name:                 d.Get("name").(string),
 		Description:          d.Get("description").(string),
@@ -82,13 +115,18 @@ func resourceGeoserverDatastoreCreate(d *schema.ResourceData, meta interface{})
 		ConnectionParameters: connectionParameters,
 	}
 
-	err := client.CreateDatastore(workspaceName, datastore)
+	err = client.CreateDatastore(workspaceName, datastore)
 	if err != nil {
 		return err
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
 
+	err = validateStoreConnection(d, meta, fmt.Sprintf("/workspaces/%s/datastores/%s/featuretypes", workspaceName, d.Get("name").(string)))
+	if err != nil {
+		return err
+	}
+
 	return resourceGeoserverDatastoreRead(d, meta)
 }
 
@@ -117,12 +155,17 @@ func resourceGeoserverDatastoreRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("enabled", datastore.Enabled)
 	d.Set("default", datastore.Default)
 
+	sensitiveConnectionParameters := hashSensitiveConnectionParameters(d.Get("sensitive_connection_params").(map[string]interface{}))
 	connectionParameters := map[string]string{}
 	for _, entry := range datastore.ConnectionParameters {
+		if _, ok := sensitiveConnectionParameters[entry.Key]; ok {
+			continue
+		}
 		connectionParameters[entry.Key] = entry.Value
 	}
 
 	d.Set("connection_params", connectionParameters)
+	d.Set("sensitive_connection_params", sensitiveConnectionParameters)
 
 	return nil
 }
@@ -136,7 +179,18 @@ func resourceGeoserverDatastoreDelete(d *schema.ResourceData, meta interface{})
 
 	client := meta.(*Config).GeoserverClient()
 
-	err := client.DeleteDatastore(workspaceName, datastoreName, true)
+	forceDestroy := d.Get("force_destroy").(bool)
+	if !forceDestroy {
+		dependents, err := storeDependents(meta, fmt.Sprintf("/workspaces/%s/datastores/%s/featuretypes", workspaceName, datastoreName), "featureTypes", "featureType")
+		if err != nil {
+			return err
+		}
+		if len(dependents) > 0 {
+			return refuseDestroy("datastore", d.Id(), dependents)
+		}
+	}
+
+	err := client.DeleteDatastore(workspaceName, datastoreName, forceDestroy)
 	if err != nil {
 		return err
 	}
@@ -153,17 +207,20 @@ func resourceGeoserverDatastoreUpdate(d *schema.ResourceData, meta interface{})
 	workspaceName := splittedID[0]
 	datastoreName := splittedID[1]
 
-	connectionParameters := []*gs.DatastoreConnectionParameter{}
-	for key, value := range d.Get("connection_params").(map[string]interface{}) {
-		connectionParameters = append(connectionParameters, &gs.DatastoreConnectionParameter{
-			Key:   key,
-			Value: value.(string),
-		})
+	client := meta.(*Config).GeoserverClient()
+
+	// The unchanged sensitive parameters are only known by their digest, Geoserver's encrypted values are sent back instead
+	current, err := client.GetDatastore(workspaceName, datastoreName)
+	if err != nil {
+		return err
 	}
 
-	client := meta.(*Config).GeoserverClient()
+	connectionParameters, err := expandDatastoreConnectionParameters(d, current.ConnectionParameters)
+	if err != nil {
+		return err
+	}
 
-	err := client.UpdateDatastore(workspaceName, datastoreName, &gs.Datastore{
+	err = client.UpdateDatastore(workspaceName, datastoreName, &gs.Datastore{
 		Name:                 d.Get("name").(string),
 		Description:          d.Get("description").(string),
 		Enabled:              d.Get("enabled").(bool),
@@ -174,6 +231,14 @@ func resourceGeoserverDatastoreUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	// The update is issued against the old name, the id follows the new one
+	d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
+
+	err = validateStoreConnection(d, meta, fmt.Sprintf("/workspaces/%s/datastores/%s/featuretypes", workspaceName, d.Get("name").(string)))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -195,3 +260,85 @@ func resourceGeoserverDatastoreImport(d *schema.ResourceData, meta interface{})
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// sensitiveConnectionParameterDigestPrefix marks the sensitive connection parameters replaced by their digest in the state
+const sensitiveConnectionParameterDigestPrefix = "sha256:"
+
+// hashSensitiveConnectionParameter returns the digest kept in the state for a sensitive connection parameter
+func hashSensitiveConnectionParameter(value string) string {
+	if strings.HasPrefix(value, sensitiveConnectionParameterDigestPrefix) {
+		return value
+	}
+
+	digest := sha256.Sum256([]byte(value))
+	return sensitiveConnectionParameterDigestPrefix + hex.EncodeToString(digest[:])
+}
+
+func hashSensitiveConnectionParameters(values map[string]interface{}) map[string]string {
+	digests := map[string]string{}
+	for key, value := range values {
+		digests[key] = hashSensitiveConnectionParameter(value.(string))
+	}
+
+	return digests
+}
+
+// suppressSensitiveConnectionParameterDiff compares the configured sensitive connection parameters with the digests of the state
+func suppressSensitiveConnectionParameterDiff(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		return false
+	}
+
+	return old != "" && old == hashSensitiveConnectionParameter(new)
+}
+
+// resourceGeoserverDatastoreCustomizeDiff rejects the connection parameters set as both plain and sensitive ones,
+// as only one of the values would be sent to Geoserver
+func resourceGeoserverDatastoreCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	sensitiveConnectionParameters := d.Get("sensitive_connection_params").(map[string]interface{})
+	connectionParameters := d.Get("connection_params").(map[string]interface{})
+
+	var duplicates []string
+	for _, key := range slices.Sorted(maps.Keys(connectionParameters)) {
+		if _, ok := sensitiveConnectionParameters[key]; ok {
+			duplicates = append(duplicates, key)
+		}
+	}
+	if len(duplicates) > 0 {
+		return fmt.Errorf("connection parameters %q are set in both connection_params and sensitive_connection_params", strings.Join(duplicates, ","))
+	}
+
+	return nil
+}
+
+// expandDatastoreConnectionParameters merges the plain and the sensitive connection parameters. The sensitive
+// parameters left unchanged only have their digest in the state, their current value is taken from the datastore.
+func expandDatastoreConnectionParameters(d *schema.ResourceData, current []*gs.DatastoreConnectionParameter) ([]*gs.DatastoreConnectionParameter, error) {
+	connectionParameters := []*gs.DatastoreConnectionParameter{}
+	for key, value := range d.Get("connection_params").(map[string]interface{}) {
+		connectionParameters = append(connectionParameters, &gs.DatastoreConnectionParameter{
+			Key:   key,
+			Value: value.(string),
+		})
+	}
+
+	oldSensitive, newSensitive := d.GetChange("sensitive_connection_params")
+	oldValues := oldSensitive.(map[string]interface{})
+	for key, value := range newSensitive.(map[string]interface{}) {
+		value := value.(string)
+		if oldValue, ok := oldValues[key]; ok && oldValue.(string) == value && strings.HasPrefix(value, sensitiveConnectionParameterDigestPrefix) {
+			index := slices.IndexFunc(current, func(entry *gs.DatastoreConnectionParameter) bool { return entry.Key == key })
+			if index < 0 {
+				return nil, fmt.Errorf("sensitive connection parameter %q is missing from the datastore, change its value to send it again", key)
+			}
+			value = current[index].Value
+		}
+
+		connectionParameters = append(connectionParameters, &gs.DatastoreConnectionParameter{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	return connectionParameters, nil
+}
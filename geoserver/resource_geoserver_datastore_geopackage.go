@@ -0,0 +1,83 @@
+package geoserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var geopackageDatastore = &typedDatastore{
+	kind: "GeoPackage",
+	fixed: map[string]string{
+		"dbtype": "geopkg",
+	},
+	parameters: []datastoreParameter{
+		{attribute: "database", key: "database"},
+		{attribute: "read_only", key: "read_only"},
+		{attribute: "min_connections", key: "min connections"},
+		{attribute: "max_connections", key: "max connections"},
+		{attribute: "connection_timeout", key: "Connection timeout"},
+		{attribute: "fetch_size", key: "fetch size"},
+		{attribute: "expose_primary_keys", key: "Expose primary keys"},
+		{attribute: "primary_key_metadata_table", key: "Primary key metadata table"},
+	},
+}
+
+func resourceGeoserverDatastoreGeopackage() *schema.Resource {
+	return &schema.Resource{
+		Create: geopackageDatastore.create,
+		Read:   geopackageDatastore.read,
+		Update: geopackageDatastore.update,
+		Delete: resourceGeoserverDatastoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: geopackageDatastore.importState,
+		},
+
+		Schema: typedDatastoreSchema(map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of the GeoPackage file, absolute or relative to the data directory, e.g. file:data/roads.gpkg.",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Open the GeoPackage in read only mode. Default value is false.",
+			},
+			"min_connections": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Minimum number of pooled connections. Default value is 1.",
+			},
+			"max_connections": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     10,
+				Description: "Maximum number of pooled connections. Default value is 10.",
+			},
+			"connection_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     20,
+				Description: "Seconds to wait for a pooled connection. Default value is 20.",
+			},
+			"fetch_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1000,
+				Description: "Number of rows read per round trip. Default value is 1000.",
+			},
+			"expose_primary_keys": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Publish the primary key columns as attributes. Default value is false.",
+			},
+			"primary_key_metadata_table": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Table describing the primary keys of the tables without one.",
+			},
+		}),
+	}
+}
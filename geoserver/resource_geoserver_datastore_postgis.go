@@ -0,0 +1,170 @@
+package geoserver
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var postgisDatastore = &typedDatastore{
+	kind: "PostGIS",
+	fixed: map[string]string{
+		"dbtype": "postgis",
+	},
+	parameters: []datastoreParameter{
+		{attribute: "host", key: "host"},
+		{attribute: "port", key: "port"},
+		{attribute: "database", key: "database"},
+		{attribute: "schema", key: "schema"},
+		{attribute: "user", key: "user"},
+		{attribute: "password", key: "passwd", writeOnly: true},
+		{attribute: "jndi_reference_name", key: "jndiReferenceName"},
+		{attribute: "min_connections", key: "min connections"},
+		{attribute: "max_connections", key: "max connections"},
+		{attribute: "connection_timeout", key: "Connection timeout"},
+		{attribute: "validate_connections", key: "validate connections"},
+		{attribute: "fetch_size", key: "fetch size"},
+		{attribute: "prepared_statements", key: "preparedStatements"},
+		{attribute: "max_open_prepared_statements", key: "Max open prepared statements"},
+		{attribute: "estimated_extends", key: "Estimated extends"},
+		{attribute: "loose_bbox", key: "Loose bbox"},
+		{attribute: "expose_primary_keys", key: "Expose primary keys"},
+		{attribute: "encode_functions", key: "encode functions"},
+		{attribute: "batch_insert_size", key: "Batch insert size"},
+	},
+	validate: func(d *schema.ResourceData) error {
+		if d.Get("jndi_reference_name").(string) == "" && (d.Get("host").(string) == "" || d.Get("database").(string) == "") {
+			return fmt.Errorf("host and database are required when jndi_reference_name is not set")
+		}
+		return nil
+	},
+}
+
+func resourceGeoserverDatastorePostgis() *schema.Resource {
+	return &schema.Resource{
+		Create: postgisDatastore.create,
+		Read:   postgisDatastore.read,
+		Update: postgisDatastore.update,
+		Delete: resourceGeoserverDatastoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: postgisDatastore.importState,
+		},
+
+		Schema: typedDatastoreSchema(map[string]*schema.Schema{
+			"host": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"jndi_reference_name"},
+				Description:   "Host of the PostgreSQL server. Required unless jndi_reference_name is set.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     5432,
+				Description: "Port of the PostgreSQL server. Default value is 5432.",
+			},
+			"database": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"jndi_reference_name"},
+				Description:   "Name of the database. Required unless jndi_reference_name is set.",
+			},
+			"schema": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "public",
+				Description: "Schema holding the tables. Default value is public.",
+			},
+			"user": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"jndi_reference_name"},
+				Description:   "User connecting to the database.",
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"jndi_reference_name"},
+				Description:   "Password of the user. Geoserver stores it encrypted, so it is never read back.",
+			},
+			"jndi_reference_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "JNDI name of a connection pool provided by the servlet container, e.g. java:comp/env/jdbc/mydatabase. Replaces host, database, user and password.",
+			},
+			"min_connections": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Minimum number of pooled connections. Default value is 1.",
+			},
+			"max_connections": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     10,
+				Description: "Maximum number of pooled connections. Default value is 10.",
+			},
+			"connection_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     20,
+				Description: "Seconds to wait for a pooled connection. Default value is 20.",
+			},
+			"validate_connections": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Check the pooled connections before using them. Default value is true.",
+			},
+			"fetch_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1000,
+				Description: "Number of rows read per round trip. Default value is 1000.",
+			},
+			"prepared_statements": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use prepared statements. Default value is false.",
+			},
+			"max_open_prepared_statements": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     50,
+				Description: "Maximum number of prepared statements kept open per connection. Default value is 50.",
+			},
+			"estimated_extends": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Compute the bounding boxes from the table statistics. Default value is true.",
+			},
+			"loose_bbox": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Filter the features on their bounding box only. Default value is true.",
+			},
+			"expose_primary_keys": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Publish the primary key columns as attributes. Default value is false.",
+			},
+			"encode_functions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Translate the filter functions into SQL. Default value is true.",
+			},
+			"batch_insert_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Number of features inserted per statement by WFS-T. Default value is 1.",
+			},
+		}),
+	}
+}
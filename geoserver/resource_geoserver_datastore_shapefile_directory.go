@@ -0,0 +1,83 @@
+package geoserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var shapefileDirectoryDatastore = &typedDatastore{
+	kind: "Shapefile directory",
+	fixed: map[string]string{
+		"fstype": "shape",
+	},
+	parameters: []datastoreParameter{
+		{attribute: "url", key: "url"},
+		{attribute: "charset", key: "charset"},
+		{attribute: "timezone", key: "timezone"},
+		{attribute: "memory_mapped_buffer", key: "memory mapped buffer"},
+		{attribute: "cache_memory_maps", key: "cache and reuse memory maps"},
+		{attribute: "create_spatial_index", key: "create spatial index"},
+		{attribute: "enable_spatial_index", key: "enable spatial index"},
+		{attribute: "skip_scan", key: "skipScan"},
+	},
+}
+
+func resourceGeoserverDatastoreShapefileDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: shapefileDirectoryDatastore.create,
+		Read:   shapefileDirectoryDatastore.read,
+		Update: shapefileDirectoryDatastore.update,
+		Delete: resourceGeoserverDatastoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: shapefileDirectoryDatastore.importState,
+		},
+
+		Schema: typedDatastoreSchema(map[string]*schema.Schema{
+			"url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Directory holding the shapefiles, absolute or relative to the data directory, e.g. file:data/shapefiles.",
+			},
+			"charset": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ISO-8859-1",
+				Description: "Charset of the DBF files. Default value is ISO-8859-1.",
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Time zone of the dates of the DBF files, e.g. Europe/Paris. Geoserver uses its own time zone when empty.",
+			},
+			"memory_mapped_buffer": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Read the files through memory mapped buffers. Default value is false.",
+			},
+			"cache_memory_maps": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Cache and reuse the memory maps. Default value is true.",
+			},
+			"create_spatial_index": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Create the spatial index of the shapefiles missing one. Default value is true.",
+			},
+			"enable_spatial_index": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Use the spatial index of the shapefiles. Default value is true.",
+			},
+			"skip_scan": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Do not scan the directory for new files after the store is opened. Default value is false.",
+			},
+		}),
+	}
+}
@@ -0,0 +1,138 @@
+package geoserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	gs "github.com/camptocamp/go-geoserver/client"
+)
+
+func TestResourceGeoserverDatastoreDuplicateConnectionParameters(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"workspace_name":              "ws",
+		"name":                        "store",
+		"connection_params":           map[string]interface{}{"dbtype": "postgis", "passwd": "secret"},
+		"sensitive_connection_params": map[string]interface{}{"passwd": "secret"},
+	})
+
+	_, err := resourceGeoserverDatastore().Diff(nil, config, nil)
+	if err == nil {
+		t.Error("expected an error for passwd set in both connection parameter maps")
+	}
+}
+
+func TestSuppressSensitiveConnectionParameterDiff(t *testing.T) {
+	digest := hashSensitiveConnectionParameter("secret")
+
+	if !suppressSensitiveConnectionParameterDiff("sensitive_connection_params.passwd", digest, "secret", nil) {
+		t.Error("expected the digest of the configured value to be suppressed")
+	}
+	if suppressSensitiveConnectionParameterDiff("sensitive_connection_params.passwd", digest, "changed", nil) {
+		t.Error("expected a changed value not to be suppressed")
+	}
+	if suppressSensitiveConnectionParameterDiff("sensitive_connection_params.passwd", "", "secret", nil) {
+		t.Error("expected a new value not to be suppressed")
+	}
+}
+
+func TestExpandDatastoreConnectionParameters(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "ws/store",
+		Attributes: map[string]string{
+			"connection_params.%":                "1",
+			"connection_params.dbtype":           "postgis",
+			"sensitive_connection_params.%":      "2",
+			"sensitive_connection_params.passwd": hashSensitiveConnectionParameter("secret"),
+			"sensitive_connection_params.user":   hashSensitiveConnectionParameter("admin"),
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"sensitive_connection_params.user": {Old: state.Attributes["sensitive_connection_params.user"], New: "geoserver"},
+		},
+	}
+	d, err := schema.InternalMap(resourceGeoserverDatastore().Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	connectionParameters, err := expandDatastoreConnectionParameters(d, []*gs.DatastoreConnectionParameter{
+		{Key: "dbtype", Value: "postgis"},
+		{Key: "passwd", Value: "crypt1:encrypted"},
+		{Key: "user", Value: "admin"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	values := map[string]string{}
+	for _, entry := range connectionParameters {
+		values[entry.Key] = entry.Value
+	}
+	for key, expected := range map[string]string{
+		"dbtype": "postgis",
+		"passwd": "crypt1:encrypted",
+		"user":   "geoserver",
+	} {
+		if values[key] != expected {
+			t.Errorf("expected %s to be %q, got %q", key, expected, values[key])
+		}
+	}
+}
+
+func TestAccGeoserverDatastore_rename(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeoserverDatastoreConfig("tf_acc_store"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("geoserver_datastore.test", "id", "tf_acc/tf_acc_store"),
+					resource.TestCheckResourceAttr("geoserver_featuretype.test", "id", "tf_acc/tf_acc_store/roads"),
+				),
+			},
+			{
+				// The datastore is renamed in place, and the feature type published from it follows it
+				Config: testAccGeoserverDatastoreConfig("tf_acc_store_renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("geoserver_datastore.test", "id", "tf_acc/tf_acc_store_renamed"),
+					resource.TestCheckResourceAttr("geoserver_featuretype.test", "id", "tf_acc/tf_acc_store_renamed/roads"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGeoserverDatastoreConfig(name string) string {
+	return fmt.Sprintf(`
+resource "geoserver_workspace" "test" {
+  name          = "tf_acc"
+  force_destroy = true
+}
+
+resource "geoserver_datastore" "test" {
+  workspace_name = geoserver_workspace.test.name
+  name           = %q
+
+  connection_params = {
+    "url"       = "file:data/sf"
+    "namespace" = geoserver_workspace.test.namespace_uri
+  }
+}
+
+resource "geoserver_featuretype" "test" {
+  workspace_name    = geoserver_workspace.test.name
+  datastore_name    = geoserver_datastore.test.name
+  name              = "roads"
+  native_name       = "roads"
+  projection_policy = "FORCE_DECLARED"
+  srs               = "EPSG:26713"
+  recalculate       = "nativebbox,latlonbbox"
+}
+`, name)
+}
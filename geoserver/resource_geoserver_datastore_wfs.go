@@ -0,0 +1,143 @@
+package geoserver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var wfsDatastore = &typedDatastore{
+	kind: "WFS",
+	parameters: []datastoreParameter{
+		{attribute: "capabilities_url", key: "WFSDataStoreFactory:GET_CAPABILITIES_URL"},
+		{attribute: "username", key: "WFSDataStoreFactory:USERNAME"},
+		{attribute: "password", key: "WFSDataStoreFactory:PASSWORD", writeOnly: true},
+		{attribute: "timeout", key: "WFSDataStoreFactory:TIMEOUT"},
+		{attribute: "buffer_size", key: "WFSDataStoreFactory:BUFFER_SIZE"},
+		{attribute: "max_features", key: "WFSDataStoreFactory:MAXFEATURES"},
+		{attribute: "encoding", key: "WFSDataStoreFactory:ENCODING"},
+		{attribute: "lenient", key: "WFSDataStoreFactory:LENIENT"},
+		{attribute: "try_gzip", key: "WFSDataStoreFactory:TRY_GZIP"},
+		{attribute: "axis_order", key: "WFSDataStoreFactory:AXIS_ORDER"},
+		{attribute: "axis_order_filter", key: "WFSDataStoreFactory:AXIS_ORDER_FILTER"},
+		{attribute: "output_format", key: "WFSDataStoreFactory:OUTPUTFORMAT"},
+		{attribute: "use_default_srs", key: "WFSDataStoreFactory:USEDEFAULTSRS"},
+		{attribute: "max_connection_pool_size", key: "WFSDataStoreFactory:MAX_CONNECTION_POOL_SIZE"},
+		{attribute: "use_http_connection_pooling", key: "WFSDataStoreFactory:USE_HTTP_CONNECTION_POOLING"},
+	},
+}
+
+func resourceGeoserverDatastoreWfs() *schema.Resource {
+	axisOrderValidateFunc := func(val interface{}, key string) (warns []string, errs []error) {
+		v := val.(string)
+		allowed_values := []string{"Compliant", "East / North", "North / East"}
+		if !slices.Contains(allowed_values, v) {
+			errs = append(errs, fmt.Errorf("%q must be one of this values %q, got: %q", key, strings.Join(allowed_values, ","), v))
+		}
+		return
+	}
+
+	return &schema.Resource{
+		Create: wfsDatastore.create,
+		Read:   wfsDatastore.read,
+		Update: wfsDatastore.update,
+		Delete: resourceGeoserverDatastoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: wfsDatastore.importState,
+		},
+
+		Schema: typedDatastoreSchema(map[string]*schema.Schema{
+			"capabilities_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GetCapabilities URL of the remote WFS, e.g. https://example.com/wfs?service=WFS&request=GetCapabilities&version=2.0.0.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User authenticating on the remote WFS.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of the user. Geoserver stores it encrypted, so it is never read back.",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3000,
+				Description: "Timeout of the requests in milliseconds. Default value is 3000.",
+			},
+			"buffer_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     10,
+				Description: "Number of features buffered while parsing the responses. Default value is 10.",
+			},
+			"max_features": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum number of features requested to the remote WFS. Default value is 0 (no limit).",
+			},
+			"encoding": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "UTF-8",
+				Description: "Encoding of the requests. Default value is UTF-8.",
+			},
+			"lenient": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Accept responses not strictly matching the schema of the remote WFS. Default value is false.",
+			},
+			"try_gzip": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Ask for gzip compressed responses. Default value is true.",
+			},
+			"axis_order": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Compliant",
+				Description:  "Axis order of the coordinates returned by the remote WFS. Authorized values are : Compliant, East / North, North / East. Default value is Compliant.",
+				ValidateFunc: axisOrderValidateFunc,
+			},
+			"axis_order_filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Compliant",
+				Description:  "Axis order of the coordinates sent in the filters. Authorized values are : Compliant, East / North, North / East. Default value is Compliant.",
+				ValidateFunc: axisOrderValidateFunc,
+			},
+			"output_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Output format requested to the remote WFS. The first GML format advertised is used when empty.",
+			},
+			"use_default_srs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Always request the default SRS of the feature types. Default value is false.",
+			},
+			"max_connection_pool_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     6,
+				Description: "Maximum number of HTTP connections to the remote WFS. Default value is 6.",
+			},
+			"use_http_connection_pooling": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Reuse the HTTP connections. Default value is true.",
+			},
+		}),
+	}
+}
@@ -3,6 +3,8 @@ package geoserver
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -10,8 +12,11 @@ import (
 	gs "github.com/camptocamp/go-geoserver/client"
 )
 
+// geometryTypes are the JTS geometry classes a geometry column can be bound to
+var geometryTypes = []string{"Geometry", "GeometryCollection", "Point", "MultiPoint", "LineString", "MultiLineString", "Polygon", "MultiPolygon"}
+
 func resourceGeoserverFeatureType() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Create: resourceGeoserverFeatureTypeCreate,
 		Read:   resourceGeoserverFeatureTypeRead,
 		Update: resourceGeoserverFeatureTypeUpdate,
@@ -19,6 +24,8 @@ func resourceGeoserverFeatureType() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceGeoserverFeatureTypeImport,
 		},
+		CustomizeDiff: resourceGeoserverFeatureTypeCustomizeDiff,
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"workspace_name": {
@@ -27,9 +34,9 @@ func resourceGeoserverFeatureType() *schema.Resource {
 				ForceNew: true,
 			},
 			"datastore_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the datastore publishing the feature type. A rename of the datastore is followed in place, the feature type is recreated when it moves to another datastore.",
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -56,6 +63,8 @@ func resourceGeoserverFeatureType() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"international_title":    internationalStringSchema("Title of the feature type by language, e.g. { en = \"Roads\", fr = \"Routes\" }."),
+			"international_abstract": internationalStringSchema("Description of the feature type by language."),
 			"native_crs_class": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -63,59 +72,17 @@ func resourceGeoserverFeatureType() *schema.Resource {
 			"native_crs_value": {
 				Type:     schema.TypeString,
 				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// The CRS derived from the native geometry SRID is not part of the configuration, Geoserver returns it as WKT
+					return new == "" && old != "" && crsEPSGCode(old) == nativeCRSValue(d)
+				},
 			},
 			"srs": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			"native_bounding_box_min_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-			},
-			"native_bounding_box_max_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-			},
-			"native_bounding_box_min_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-			},
-			"native_bounding_box_max_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-			},
-			"native_bounding_box_crs_class": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"native_bounding_box_crs_value": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"lat_lon_bounding_box_min_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-			},
-			"lat_lon_bounding_box_max_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-			},
-			"lat_lon_bounding_box_min_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-			},
-			"lat_lon_bounding_box_max_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-			},
-			"lat_lon_bounding_box_crs_class": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"lat_lon_bounding_box_crs_value": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
+			"native_bounding_box":  boundingBoxSchema("Bounding box of the feature type in its native CRS.", false),
+			"lat_lon_bounding_box": boundingBoxSchema("Bounding box of the feature type in EPSG:4326.", false),
 			"use_custom_attributes": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -153,8 +120,192 @@ func resourceGeoserverFeatureType() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"keywords": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Keywords of the feature type.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The keyword.",
+						},
+						"language": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Language of the keyword, e.g. en.",
+						},
+						"vocabulary": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Vocabulary (thesaurus) the keyword belongs to.",
+						},
+					},
+				},
+			},
+			"metadata_link": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Links to the metadata documents describing the feature type.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Mime type of the document, e.g. text/xml.",
+						},
+						"metadata_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Standard of the document. Authorized values are : ISO19115:2003, FGDC, TC211, 19139, other.",
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := val.(string)
+								allowed_values := []string{"ISO19115:2003", "FGDC", "TC211", "19139", "other"}
+								if !slices.Contains(allowed_values, v) {
+									errs = append(errs, fmt.Errorf("%q must be one of this values %q, got: %q", key, strings.Join(allowed_values, ","), v))
+								}
+								return
+							},
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "URL of the document.",
+						},
+					},
+				},
+			},
+			"data_link": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Links to the data of the feature type.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Mime type of the data, e.g. text/html.",
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "URL of the data.",
+						},
+					},
+				},
+			},
+			"response_srs": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "EPSG codes advertised by WFS for the feature type, e.g. 4326. Only used when overriding_service_srs is true.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"overriding_service_srs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Advertise response_srs instead of the SRS list of the WFS service. Default value is false.",
+			},
+			"service_configuration": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Restrict the services publishing the feature type. Default value is false.",
+			},
+			"disabled_services": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Services not publishing the feature type when service_configuration is true, e.g. WFS or WMS.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max_features": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum number of features returned by a WFS request. Default value is 0 (no limit).",
+			},
+			"num_decimals": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Number of decimals of the coordinates returned by WFS. Default value is 0 (service setting).",
+			},
+			"cql_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CQL filter restricting the features published by the feature type.",
+			},
+			"skip_number_matched": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip the count of the matched features in WFS responses. Default value is false.",
+			},
+			"advertised": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Is the feature type advertised in the capabilities? Default value is true.",
+			},
+			"recalculate":         recalculateSchema(),
+			"refresh_bounds_on":   refreshBoundsOnSchema(),
+			"sql_view":            sqlViewSchema(),
+			"time_dimension":      dimensionSchema("Time dimension of the feature type. Stored in the time metadata entry.", false),
+			"elevation_dimension": dimensionSchema("Elevation dimension of the feature type. Stored in the elevation metadata entry.", false),
+			"create_native": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Create the native table in the datastore from the attribute set and the native geometry. The datastore must be writable and the table must not exist. The table is not dropped when the feature type is destroyed. Default value is false.",
+			},
+			"native_geometry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				ForceNew:    true,
+				Description: "Geometry column of the native table created when create_native is true.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "geom",
+							Description: "Name of the geometry column. Default value is geom.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Type of the geometry column. Authorized values are : Geometry, GeometryCollection, Point, MultiPoint, LineString, MultiLineString, Polygon, MultiPolygon.",
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := val.(string)
+								if !slices.Contains(geometryTypes, v) {
+									errs = append(errs, fmt.Errorf("%q must be one of this values %q, got: %q", key, strings.Join(geometryTypes, ","), v))
+								}
+								return
+							},
+						},
+						"srid": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "SRID of the geometry column. Declared as native CRS of the feature type when native_crs_value is empty.",
+						},
+					},
+				},
+			},
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		boundingBoxStateUpgrader(resource, "native_bounding_box", "lat_lon_bounding_box"),
+	}
+
+	return resource
 }
 
 func resourceGeoserverFeatureTypeCreate(d *schema.ResourceData, meta interface{}) error {
@@ -177,6 +328,11 @@ func resourceGeoserverFeatureTypeCreate(d *schema.ResourceData, meta interface{}
 		})
 	}
 
+	nativeGeometry := expandNativeGeometry(d)
+	if nativeGeometry != nil {
+		attributes = append(attributes, nativeGeometry)
+	}
+
 	var metadata []*gs.FeatureTypeMetadata
 	for key, value := range d.Get("metadata").(map[string]interface{}) {
 		metadata = append(metadata, &gs.FeatureTypeMetadata{
@@ -185,6 +341,12 @@ func resourceGeoserverFeatureTypeCreate(d *schema.ResourceData, meta interface{}
 		})
 	}
 
+	// The SQL view and the dimensions are structured entries, sent with the descriptors once the feature type exists
+	restMetadata, err := expandFeatureTypeMetadata(d)
+	if err != nil {
+		return err
+	}
+
 	featureType := &gs.FeatureType{
 		Name:             d.Get("name").(string),
 		NativeName:       d.Get("native_name").(string),
@@ -194,34 +356,20 @@ func resourceGeoserverFeatureTypeCreate(d *schema.ResourceData, meta interface{}
 		Abstract:         d.Get("abstract").(string),
 		NativeCRS: gs.FeatureTypeCRS{
 			Class: d.Get("native_crs_class").(string),
-			Value: d.Get("native_crs_value").(string),
-		},
-		SRS: d.Get("srs").(string),
-		NativeBoundingBox: gs.BoundingBox{
-			MinX: d.Get("native_bounding_box_min_x").(float64),
-			MaxX: d.Get("native_bounding_box_max_x").(float64),
-			MinY: d.Get("native_bounding_box_min_y").(float64),
-			MaxY: d.Get("native_bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("native_bounding_box_crs_class").(string),
-				Value: d.Get("native_bounding_box_crs_value").(string),
-			},
-		},
-		LatLonBoundingBox: gs.BoundingBox{
-			MinX: d.Get("lat_lon_bounding_box_min_x").(float64),
-			MaxX: d.Get("lat_lon_bounding_box_max_x").(float64),
-			MinY: d.Get("lat_lon_bounding_box_min_y").(float64),
-			MaxY: d.Get("lat_lon_bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("lat_lon_bounding_box_crs_class").(string),
-				Value: d.Get("lat_lon_bounding_box_crs_value").(string),
-			},
+			Value: nativeCRSValue(d),
 		},
-		Attributes: attributes,
-		Metadata:   metadata,
+		SRS:               d.Get("srs").(string),
+		NativeBoundingBox: expandBoundingBox(d, "native_bounding_box"),
+		LatLonBoundingBox: expandBoundingBox(d, "lat_lon_bounding_box"),
+		Attributes:        attributes,
+		Metadata:          metadata,
 	}
 
-	err := client.CreateFeatureType(workspaceName, datastoreName, featureType)
+	if d.Get("create_native").(bool) && len(attributes) == 0 {
+		return fmt.Errorf("create_native requires at least one attribute or a native_geometry")
+	}
+
+	err = client.CreateFeatureType(workspaceName, datastoreName, featureType)
 	if err != nil {
 		client.DeleteFeatureType(workspaceName, datastoreName, d.Get("name").(string), true)
 		return err
@@ -230,6 +378,11 @@ func resourceGeoserverFeatureTypeCreate(d *schema.ResourceData, meta interface{}
 	d.SetId(fmt.Sprintf("%s/%s/%s", workspaceName, datastoreName, d.Get("name").(string)))
 	d.Set("use_custom_attributes", len(attributes) > 0)
 
+	err = updateFeatureTypeDescriptors(d, meta, workspaceName, datastoreName, d.Get("name").(string), restMetadata)
+	if err != nil {
+		return err
+	}
+
 	return resourceGeoserverFeatureTypeRead(d, meta)
 }
 
@@ -263,23 +416,22 @@ func resourceGeoserverFeatureTypeRead(d *schema.ResourceData, meta interface{})
 	d.Set("native_crs_class", featureType.NativeCRS.Class)
 	d.Set("native_crs_value", featureType.NativeCRS.Value)
 
-	d.Set("native_bounding_box_min_x", featureType.NativeBoundingBox.MinX)
-	d.Set("native_bounding_box_max_x", featureType.NativeBoundingBox.MaxX)
-	d.Set("native_bounding_box_min_y", featureType.NativeBoundingBox.MinY)
-	d.Set("native_bounding_box_max_y", featureType.NativeBoundingBox.MaxY)
-	d.Set("native_bounding_box_crs_class", featureType.NativeBoundingBox.CRS.Class)
-	d.Set("native_bounding_box_crs_value", featureType.NativeBoundingBox.CRS.Value)
+	d.Set("native_bounding_box", flattenBoundingBox(featureType.NativeBoundingBox))
+
+	d.Set("lat_lon_bounding_box", flattenBoundingBox(featureType.LatLonBoundingBox))
 
-	d.Set("lat_lon_bounding_box_min_x", featureType.LatLonBoundingBox.MinX)
-	d.Set("lat_lon_bounding_box_max_x", featureType.LatLonBoundingBox.MaxX)
-	d.Set("lat_lon_bounding_box_min_y", featureType.LatLonBoundingBox.MinY)
-	d.Set("lat_lon_bounding_box_max_y", featureType.LatLonBoundingBox.MaxY)
-	d.Set("lat_lon_bounding_box_crs_class", featureType.LatLonBoundingBox.CRS.Class)
-	d.Set("lat_lon_bounding_box_crs_value", featureType.LatLonBoundingBox.CRS.Value)
+	var geometryName string
+	if nativeGeometry := expandNativeGeometry(d); nativeGeometry != nil {
+		geometryName = nativeGeometry.Name
+	}
 
 	var attributes []map[string]interface{}
 	if d.Get("use_custom_attributes").(bool) {
 		for _, value := range featureType.Attributes {
+			// The geometry column of a created table is managed by native_geometry
+			if value.Name == geometryName {
+				continue
+			}
 			attributes = append(attributes, map[string]interface{}{
 				"name":       value.Name,
 				"nillable":   value.Nillable,
@@ -291,15 +443,7 @@ func resourceGeoserverFeatureTypeRead(d *schema.ResourceData, meta interface{})
 		d.Set("attribute", attributes)
 	}
 
-	metadata := map[string]interface{}{}
-	if featureType.Metadata != nil {
-		for _, entry := range featureType.Metadata {
-			metadata[entry.Key] = entry.Value
-		}
-		d.Set("metadata", metadata)
-	}
-
-	return nil
+	return readFeatureTypeDescriptors(d, meta, workspaceName, datastoreName, featureTypeName)
 }
 
 func resourceGeoserverFeatureTypeDelete(d *schema.ResourceData, meta interface{}) error {
@@ -342,7 +486,7 @@ func resourceGeoserverFeatureTypeUpdate(d *schema.ResourceData, meta interface{}
 
 	splittedID := strings.Split(d.Id(), "/")
 	workspaceName := splittedID[0]
-	datastoreName := splittedID[1]
+	datastoreName := updatedStoreName(d, "datastore_name", splittedID[1])
 	featureTypeName := splittedID[2]
 
 	client := meta.(*Config).GeoserverClient()
@@ -359,6 +503,11 @@ func resourceGeoserverFeatureTypeUpdate(d *schema.ResourceData, meta interface{}
 		})
 	}
 
+	nativeGeometry := expandNativeGeometry(d)
+	if nativeGeometry != nil {
+		attributes = append(attributes, nativeGeometry)
+	}
+
 	var metadata []*gs.FeatureTypeMetadata
 	for key, value := range d.Get("metadata").(map[string]interface{}) {
 		metadata = append(metadata, &gs.FeatureTypeMetadata{
@@ -367,6 +516,12 @@ func resourceGeoserverFeatureTypeUpdate(d *schema.ResourceData, meta interface{}
 		})
 	}
 
+	// The SQL view and the dimensions are structured entries, sent with the descriptors once the feature type exists
+	restMetadata, err := expandFeatureTypeMetadata(d)
+	if err != nil {
+		return err
+	}
+
 	featureType := &gs.FeatureType{
 		Name:             d.Get("name").(string),
 		NativeName:       d.Get("native_name").(string),
@@ -376,35 +531,32 @@ func resourceGeoserverFeatureTypeUpdate(d *schema.ResourceData, meta interface{}
 		Abstract:         d.Get("abstract").(string),
 		NativeCRS: gs.FeatureTypeCRS{
 			Class: d.Get("native_crs_class").(string),
-			Value: d.Get("native_crs_value").(string),
-		},
-		SRS: d.Get("srs").(string),
-		NativeBoundingBox: gs.BoundingBox{
-			MinX: d.Get("native_bounding_box_min_x").(float64),
-			MaxX: d.Get("native_bounding_box_max_x").(float64),
-			MinY: d.Get("native_bounding_box_min_y").(float64),
-			MaxY: d.Get("native_bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("native_bounding_box_crs_class").(string),
-				Value: d.Get("native_bounding_box_crs_value").(string),
-			},
-		},
-		LatLonBoundingBox: gs.BoundingBox{
-			MinX: d.Get("lat_lon_bounding_box_min_x").(float64),
-			MaxX: d.Get("lat_lon_bounding_box_max_x").(float64),
-			MinY: d.Get("lat_lon_bounding_box_min_y").(float64),
-			MaxY: d.Get("lat_lon_bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("lat_lon_bounding_box_crs_class").(string),
-				Value: d.Get("lat_lon_bounding_box_crs_value").(string),
-			},
+			Value: nativeCRSValue(d),
 		},
-		Attributes: attributes,
-		Metadata:   metadata,
+		SRS:               d.Get("srs").(string),
+		NativeBoundingBox: expandBoundingBox(d, "native_bounding_box"),
+		LatLonBoundingBox: expandBoundingBox(d, "lat_lon_bounding_box"),
+		Attributes:        attributes,
+		Metadata:          metadata,
 	}
 
 	sync_attributes := !d.Get("use_custom_attributes").(bool)
-	err := client.UpdateFeatureType(workspaceName, datastoreName, featureTypeName, featureType, sync_attributes)
+	err = client.UpdateFeatureType(workspaceName, datastoreName, featureTypeName, featureType, sync_attributes)
+	if err != nil {
+		if d.HasChange("datastore_name") {
+			return storeMoveError("feature type", d.Id(), datastoreName, err)
+		}
+		return err
+	}
+
+	// The id follows the renames of the datastore and of the feature type
+	id, err := rewriteStoreLayerID(d.Id(), datastoreName, d.Get("name").(string))
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	err = updateFeatureTypeDescriptors(d, meta, workspaceName, datastoreName, d.Get("name").(string), restMetadata)
 	if err != nil {
 		return err
 	}
@@ -412,6 +564,65 @@ func resourceGeoserverFeatureTypeUpdate(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// resourceGeoserverFeatureTypeCustomizeDiff recreates a created native table when its attributes change, as Geoserver does not alter existing tables,
+// and a feature type moved to another datastore
+func resourceGeoserverFeatureTypeCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("create_native").(bool) && d.Id() != "" && d.HasChange("attribute") {
+		return d.ForceNew("attribute")
+	}
+
+	return forceNewOnStoreMove(d, meta, "datastore_name", "datastores")
+}
+
+// expandNativeGeometry returns the geometry attribute of the native table to create, nil if not set
+func expandNativeGeometry(d *schema.ResourceData) *gs.FeatureTypeAttribute {
+	geometries := d.Get("native_geometry").([]interface{})
+	if !d.Get("create_native").(bool) || len(geometries) == 0 || geometries[0] == nil {
+		return nil
+	}
+	v := geometries[0].(map[string]interface{})
+
+	return &gs.FeatureTypeAttribute{
+		Name:      v["name"].(string),
+		Nillable:  true,
+		Binding:   fmt.Sprintf("org.locationtech.jts.geom.%s", v["type"].(string)),
+		MinOccurs: 0,
+		MaxOccurs: 1,
+	}
+}
+
+// nativeCRSValue falls back on the SRID of the native geometry when no native CRS is declared
+func nativeCRSValue(d *schema.ResourceData) string {
+	value := d.Get("native_crs_value").(string)
+	geometries := d.Get("native_geometry").([]interface{})
+	if value != "" || !d.Get("create_native").(bool) || len(geometries) == 0 || geometries[0] == nil {
+		return value
+	}
+
+	srid := geometries[0].(map[string]interface{})["srid"].(int)
+	if srid == 0 {
+		return value
+	}
+
+	return fmt.Sprintf("EPSG:%d", srid)
+}
+
+var crsAuthorityRegexp = regexp.MustCompile(`(?:AUTHORITY|ID)\[\s*"EPSG"\s*,\s*"?(\d+)"?\s*\]`)
+
+// crsEPSGCode returns a CRS as EPSG:<code>, whether it is already a code or a WKT definition. The authority of a WKT
+// definition is the last one, the ones before belonging to its datum, ellipsoid or axes. Empty is returned otherwise.
+func crsEPSGCode(value string) string {
+	if strings.HasPrefix(value, "EPSG:") {
+		return value
+	}
+
+	authorities := crsAuthorityRegexp.FindAllStringSubmatch(value, -1)
+	if len(authorities) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("EPSG:%s", authorities[len(authorities)-1][1])
+}
+
 func resourceGeoserverFeatureTypeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	splittedID := strings.Split(d.Id(), "/")
 	workspaceName := splittedID[0]
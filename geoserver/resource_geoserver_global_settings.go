@@ -0,0 +1,223 @@
+package geoserver
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type restJAI struct {
+	TileThreads     int     `json:"tileThreads"`
+	TilePriority    int     `json:"tilePriority"`
+	MemoryCapacity  float64 `json:"memoryCapacity"`
+	MemoryThreshold float64 `json:"memoryThreshold"`
+}
+
+type restCoverageAccess struct {
+	CorePoolSize          int    `json:"corePoolSize"`
+	MaxPoolSize           int    `json:"maxPoolSize"`
+	KeepAliveTime         int    `json:"keepAliveTime"`
+	QueueType             string `json:"queueType"`
+	ImageIOCacheThreshold int    `json:"imageIOCacheThreshold"`
+}
+
+type globalSettingsBody struct {
+	Global struct {
+		Settings              restSettings       `json:"settings"`
+		JAI                   restJAI            `json:"jai"`
+		CoverageAccess        restCoverageAccess `json:"coverageAccess"`
+		FeatureTypeCacheSize  int                `json:"featureTypeCacheSize"`
+		ResourceErrorHandling string             `json:"resourceErrorHandling"`
+	} `json:"global"`
+}
+
+func resourceGeoserverGlobalSettings() *schema.Resource {
+	attributes := settingsAttributes()
+	attributes["feature_type_cache_size"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     0,
+		Description: "Number of feature types kept in memory. Default value is 0 (Geoserver default).",
+	}
+	attributes["resource_error_handling"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "SKIP_MISCONFIGURED_LAYERS",
+		Description: "Behaviour of the capabilities documents when a layer is misconfigured. Authorized values are : OGC_EXCEPTION_REPORT, SKIP_MISCONFIGURED_LAYERS. Default value is SKIP_MISCONFIGURED_LAYERS.",
+		ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+			v := val.(string)
+			allowed_values := []string{"OGC_EXCEPTION_REPORT", "SKIP_MISCONFIGURED_LAYERS"}
+			if !slices.Contains(allowed_values, v) {
+				errs = append(errs, fmt.Errorf("%q must be one of this values %q, got: %q", key, strings.Join(allowed_values, ","), v))
+			}
+			return
+		},
+	}
+	attributes["jai_tile_cache_memory_capacity"] = &schema.Schema{
+		Type:        schema.TypeFloat,
+		Optional:    true,
+		Default:     0.5,
+		Description: "Share of the heap memory used by the JAI tile cache. Default value is 0.5.",
+	}
+	attributes["jai_tile_cache_memory_threshold"] = &schema.Schema{
+		Type:        schema.TypeFloat,
+		Optional:    true,
+		Default:     0.75,
+		Description: "Share of the JAI tile cache kept when it is flushed. Default value is 0.75.",
+	}
+	attributes["jai_tile_threads"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     7,
+		Description: "Number of threads computing the JAI tiles. Default value is 7.",
+	}
+	attributes["jai_tile_priority"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     5,
+		Description: "Priority of the threads computing the JAI tiles. Default value is 5.",
+	}
+	attributes["coverage_access_core_pool_size"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     5,
+		Description: "Number of threads kept in the coverage access thread pool. Default value is 5.",
+	}
+	attributes["coverage_access_max_pool_size"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     10,
+		Description: "Maximum number of threads of the coverage access thread pool. Default value is 10.",
+	}
+	attributes["coverage_access_keep_alive_time"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     30000,
+		Description: "Time in milliseconds an idle thread of the coverage access thread pool is kept. Default value is 30000.",
+	}
+	attributes["coverage_access_queue_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "UNBOUNDED",
+		Description: "Type of the queue of the coverage access thread pool. Authorized values are : UNBOUNDED, DIRECT. Default value is UNBOUNDED.",
+		ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+			v := val.(string)
+			allowed_values := []string{"UNBOUNDED", "DIRECT"}
+			if !slices.Contains(allowed_values, v) {
+				errs = append(errs, fmt.Errorf("%q must be one of this values %q, got: %q", key, strings.Join(allowed_values, ","), v))
+			}
+			return
+		},
+	}
+	attributes["coverage_access_image_io_cache_threshold"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     10240,
+		Description: "Size in KB above which the coverages are read through a file based ImageIO cache. Default value is 10240.",
+	}
+
+	return &schema.Resource{
+		Description: "Manage the global settings of Geoserver. The settings are a singleton so Create is similar to Update and Delete has no effect.",
+		Create:      resourceGeoserverGlobalSettingsCreate,
+		Read:        resourceGeoserverGlobalSettingsRead,
+		Update:      resourceGeoserverGlobalSettingsUpdate,
+		Delete:      resourceGeoserverGlobalSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGeoserverGlobalSettingsImport,
+		},
+
+		Schema: attributes,
+	}
+}
+
+func resourceGeoserverGlobalSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Configuring Geoserver Global Settings")
+
+	err := resourceGeoserverGlobalSettingsUpdate(d, meta)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("global_settings")
+
+	return resourceGeoserverGlobalSettingsRead(d, meta)
+}
+
+func resourceGeoserverGlobalSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Geoserver Global Settings: %s", d.Id())
+
+	client := meta.(*Config).RestClient()
+
+	var body globalSettingsBody
+	err := client.GetJSON("/settings.json", &body)
+	if err != nil {
+		return err
+	}
+
+	global := body.Global
+	flattenSettings(d, global.Settings)
+	d.Set("feature_type_cache_size", global.FeatureTypeCacheSize)
+	d.Set("resource_error_handling", global.ResourceErrorHandling)
+	d.Set("jai_tile_cache_memory_capacity", global.JAI.MemoryCapacity)
+	d.Set("jai_tile_cache_memory_threshold", global.JAI.MemoryThreshold)
+	d.Set("jai_tile_threads", global.JAI.TileThreads)
+	d.Set("jai_tile_priority", global.JAI.TilePriority)
+	d.Set("coverage_access_core_pool_size", global.CoverageAccess.CorePoolSize)
+	d.Set("coverage_access_max_pool_size", global.CoverageAccess.MaxPoolSize)
+	d.Set("coverage_access_keep_alive_time", global.CoverageAccess.KeepAliveTime)
+	d.Set("coverage_access_queue_type", global.CoverageAccess.QueueType)
+	d.Set("coverage_access_image_io_cache_threshold", global.CoverageAccess.ImageIOCacheThreshold)
+
+	return nil
+}
+
+func resourceGeoserverGlobalSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Geoserver Global Settings: %s", d.Id())
+
+	client := meta.(*Config).RestClient()
+
+	var body globalSettingsBody
+	body.Global.Settings = expandSettings(d)
+	body.Global.FeatureTypeCacheSize = d.Get("feature_type_cache_size").(int)
+	body.Global.ResourceErrorHandling = d.Get("resource_error_handling").(string)
+	body.Global.JAI = restJAI{
+		MemoryCapacity:  d.Get("jai_tile_cache_memory_capacity").(float64),
+		MemoryThreshold: d.Get("jai_tile_cache_memory_threshold").(float64),
+		TileThreads:     d.Get("jai_tile_threads").(int),
+		TilePriority:    d.Get("jai_tile_priority").(int),
+	}
+	body.Global.CoverageAccess = restCoverageAccess{
+		CorePoolSize:          d.Get("coverage_access_core_pool_size").(int),
+		MaxPoolSize:           d.Get("coverage_access_max_pool_size").(int),
+		KeepAliveTime:         d.Get("coverage_access_keep_alive_time").(int),
+		QueueType:             d.Get("coverage_access_queue_type").(string),
+		ImageIOCacheThreshold: d.Get("coverage_access_image_io_cache_threshold").(int),
+	}
+
+	// The other JAI flags, the global services toggle, etc. are kept as they are
+	return client.MergeJSON("/settings", &body)
+}
+
+func resourceGeoserverGlobalSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Global Settings cannot be deleted. Skipping action")
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceGeoserverGlobalSettingsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.SetId("global_settings")
+
+	log.Printf("[INFO] Importing Geoserver Global Settings")
+
+	err := resourceGeoserverGlobalSettingsRead(d, meta)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,24 @@
+package geoserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var groupRoleAssignment = &roleAssignment{
+	kind:  "group",
+	label: "Group",
+}
+
+func resourceGeoserverGroupRole() *schema.Resource {
+	return &schema.Resource{
+		Description: "Give a role to a group. Removing the resource takes the role back from the group.",
+		Create:      groupRoleAssignment.create,
+		Read:        groupRoleAssignment.read,
+		Delete:      groupRoleAssignment.delete,
+		Importer: &schema.ResourceImporter{
+			State: groupRoleAssignment.importState,
+		},
+
+		Schema: groupRoleAssignment.schema(),
+	}
+}
@@ -11,7 +11,7 @@ import (
 )
 
 func resourceGeoserverLayerGroup() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Create: resourceGeoserverLayerGroupCreate,
 		Read:   resourceGeoserverLayerGroupRead,
 		Update: resourceGeoserverLayerGroupUpdate,
@@ -19,15 +19,18 @@ func resourceGeoserverLayerGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceGeoserverLayerGroupImport,
 		},
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"workspace_name": {
 				Type:     schema.TypeString,
 				Optional: true,
+				ForceNew: true,
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the layer group. Used to compute the id of the resource.",
 			},
 			"mode": {
 				Type:     schema.TypeString,
@@ -42,30 +45,7 @@ func resourceGeoserverLayerGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"bounding_box_min_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-			},
-			"bounding_box_max_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-			},
-			"bounding_box_min_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-			},
-			"bounding_box_max_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-			},
-			"bounding_box_crs_class": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"bounding_box_crs_value": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
+			"bounding_box": boundingBoxSchema("Bounding box of the layer group.", false),
 			"metadatalink": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -121,8 +101,24 @@ func resourceGeoserverLayerGroup() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"international_title":    internationalStringSchema("Title of the layer group by language, e.g. { en = \"Roads\", fr = \"Routes\" }."),
+			"international_abstract": internationalStringSchema("Description of the layer group by language."),
+			"international_keywords": internationalKeywordsSchema("Keywords of the layer group in a given language."),
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		boundingBoxStateUpgrader(resource, "bounding_box"),
+	}
+
+	return resource
+}
+
+func layerGroupPath(workspaceName string, layerGroupName string) string {
+	if workspaceName == "" {
+		return fmt.Sprintf("/layergroups/%s", layerGroupName)
+	}
+	return fmt.Sprintf("/workspaces/%s/layergroups/%s", workspaceName, layerGroupName)
 }
 
 func resourceGeoserverLayerGroupCreate(d *schema.ResourceData, meta interface{}) error {
@@ -160,23 +156,16 @@ func resourceGeoserverLayerGroupCreate(d *schema.ResourceData, meta interface{})
 		})
 	}
 
+	bounds := expandBoundingBox(d, "bounding_box")
+
 	layerGroup := &gs.LayerGroup{
-		Name:         d.Get("name").(string),
-		Mode:         d.Get("mode").(string),
-		Title:        d.Get("title").(string),
-		Abstract:     d.Get("abstract").(string),
-		Publishables: layers,
-		Styles:       styles,
-		Bounds: &gs.BoundingBox{
-			MinX: d.Get("bounding_box_min_x").(float64),
-			MaxX: d.Get("bounding_box_max_x").(float64),
-			MinY: d.Get("bounding_box_min_y").(float64),
-			MaxY: d.Get("bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("bounding_box_crs_class").(string),
-				Value: d.Get("bounding_box_crs_value").(string),
-			},
-		},
+		Name:          d.Get("name").(string),
+		Mode:          d.Get("mode").(string),
+		Title:         d.Get("title").(string),
+		Abstract:      d.Get("abstract").(string),
+		Publishables:  layers,
+		Styles:        styles,
+		Bounds:        &bounds,
 		MetadataLinks: metadatas,
 		Keywords:      gs.LayerGroupKeywords{Keywords: keywords},
 	}
@@ -187,7 +176,14 @@ func resourceGeoserverLayerGroupCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	// The layer group exists from now on, the state must track it even if the translations fail
 	d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
+
+	err = updateInternationalDescriptors(d, meta, layerGroupPath(workspaceName, d.Get("name").(string)), "layerGroup")
+	if err != nil {
+		return err
+	}
+
 	return resourceGeoserverLayerGroupRead(d, meta)
 }
 
@@ -216,12 +212,9 @@ func resourceGeoserverLayerGroupRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("title", layerGroup.Title)
 	d.Set("abstract", layerGroup.Abstract)
 
-	d.Set("bounding_box_min_x", layerGroup.Bounds.MinX)
-	d.Set("bounding_box_max_x", layerGroup.Bounds.MaxX)
-	d.Set("bounding_box_min_y", layerGroup.Bounds.MinY)
-	d.Set("bounding_box_max_y", layerGroup.Bounds.MaxY)
-	d.Set("bounding_box_crs_class", layerGroup.Bounds.CRS.Class)
-	d.Set("bounding_box_crs_value", layerGroup.Bounds.CRS.Value)
+	if layerGroup.Bounds != nil {
+		d.Set("bounding_box", flattenBoundingBox(*layerGroup.Bounds))
+	}
 
 	var metadataLinks []map[string]interface{}
 	for _, value := range layerGroup.MetadataLinks {
@@ -233,14 +226,6 @@ func resourceGeoserverLayerGroupRead(d *schema.ResourceData, meta interface{}) e
 	}
 	d.Set("metadatalink", metadataLinks)
 
-	var keywords []map[string]interface{}
-	for _, value := range layerGroup.Keywords.Keywords {
-		keywords = append(keywords, map[string]interface{}{
-			"string": value,
-		})
-	}
-	d.Set("keywords", keywords)
-
 	var layers []map[string]interface{}
 	for index, value := range layerGroup.Publishables {
 		layers = append(layers, map[string]interface{}{
@@ -251,7 +236,8 @@ func resourceGeoserverLayerGroupRead(d *schema.ResourceData, meta interface{}) e
 	}
 	d.Set("layers", layers)
 
-	return nil
+	// The keywords are read along the translations, to split the plain and the international ones
+	return readInternationalDescriptors(d, meta, layerGroupPath(workspaceName, groupName), "layerGroup")
 }
 
 func resourceGeoserverLayerGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -278,9 +264,24 @@ func resourceGeoserverLayerGroupUpdate(d *schema.ResourceData, meta interface{})
 
 	splittedID := strings.Split(d.Id(), "/")
 	workspaceName := splittedID[0]
+	layerGroupName := splittedID[1]
 
 	client := meta.(*Config).GeoserverClient()
 
+	// The layer group is renamed against its old name first, the update below being issued against the new one
+	if d.Get("name").(string) != layerGroupName {
+		err := meta.(*Config).RestClient().MergeJSON(layerGroupPath(workspaceName, layerGroupName), map[string]interface{}{
+			"layerGroup": map[string]interface{}{
+				"name": d.Get("name").(string),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
+	}
+
 	var metadatas []*gs.MetadataLink
 	for _, value := range d.Get("metadatalink").(*schema.Set).List() {
 		v := value.(map[string]interface{})
@@ -309,23 +310,16 @@ func resourceGeoserverLayerGroupUpdate(d *schema.ResourceData, meta interface{})
 		})
 	}
 
+	bounds := expandBoundingBox(d, "bounding_box")
+
 	layerGroup := &gs.LayerGroup{
-		Name:         d.Get("name").(string),
-		Mode:         d.Get("mode").(string),
-		Title:        d.Get("title").(string),
-		Abstract:     d.Get("abstract").(string),
-		Publishables: layers,
-		Styles:       styles,
-		Bounds: &gs.BoundingBox{
-			MinX: d.Get("bounding_box_min_x").(float64),
-			MaxX: d.Get("bounding_box_max_x").(float64),
-			MinY: d.Get("bounding_box_min_y").(float64),
-			MaxY: d.Get("bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("bounding_box_crs_class").(string),
-				Value: d.Get("bounding_box_crs_value").(string),
-			},
-		},
+		Name:          d.Get("name").(string),
+		Mode:          d.Get("mode").(string),
+		Title:         d.Get("title").(string),
+		Abstract:      d.Get("abstract").(string),
+		Publishables:  layers,
+		Styles:        styles,
+		Bounds:        &bounds,
 		MetadataLinks: metadatas,
 		Keywords:      gs.LayerGroupKeywords{Keywords: keywords},
 	}
@@ -335,7 +329,7 @@ func resourceGeoserverLayerGroupUpdate(d *schema.ResourceData, meta interface{})
 		return errUpdateGroup
 	}
 
-	return nil
+	return updateInternationalDescriptors(d, meta, layerGroupPath(workspaceName, d.Get("name").(string)), "layerGroup")
 }
 
 func resourceGeoserverLayerGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
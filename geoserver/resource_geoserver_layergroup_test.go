@@ -0,0 +1,77 @@
+package geoserver
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccGeoserverLayerGroup_rename(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGeoserverLayerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeoserverLayerGroupConfig("tf_acc_group"),
+				Check:  resource.TestCheckResourceAttr("geoserver_layergroup.test", "id", "/tf_acc_group"),
+			},
+			{
+				// The layer group is renamed in place, the id following the new name
+				Config: testAccGeoserverLayerGroupConfig("tf_acc_group_renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("geoserver_layergroup.test", "id", "/tf_acc_group_renamed"),
+					resource.TestCheckResourceAttr("geoserver_layergroup.test", "title", "Acceptance test group"),
+					testAccCheckGeoserverLayerGroupMissing("", "tf_acc_group"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGeoserverLayerGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "geoserver_layergroup" "test" {
+  name  = %q
+  title = "Acceptance test group"
+
+  layers {
+    name  = "topp:states"
+    style = "population"
+  }
+}
+`, name)
+}
+
+func testAccCheckGeoserverLayerGroupMissing(workspaceName string, layerGroupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Config).GeoserverClient()
+
+		layerGroup, err := client.GetGroup(workspaceName, layerGroupName)
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return err
+		}
+		if layerGroup != nil {
+			return fmt.Errorf("layer group %s/%s still exists", workspaceName, layerGroupName)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGeoserverLayerGroupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "geoserver_layergroup" {
+			continue
+		}
+
+		splittedID := strings.Split(rs.Primary.ID, "/")
+		err := testAccCheckGeoserverLayerGroupMissing(splittedID[0], splittedID[1])(s)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
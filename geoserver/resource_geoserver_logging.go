@@ -0,0 +1,196 @@
+package geoserver
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type restLogging struct {
+	Level         string `json:"level"`
+	Location      string `json:"location"`
+	StdOutLogging bool   `json:"stdOutLogging"`
+	// The request logging toggles are only sent when set, older Geoserver rejecting them
+	RequestLoggingEnabled *bool `json:"requestLoggingEnabled,omitempty"`
+	RequestLoggingBodies  *bool `json:"requestLoggingBodies,omitempty"`
+	RequestLoggingHeaders *bool `json:"requestLoggingHeaders,omitempty"`
+}
+
+type loggingBody struct {
+	Logging restLogging `json:"logging"`
+}
+
+func resourceGeoserverLogging() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage the logging configuration of Geoserver. The configuration is a singleton so Create is similar to Update and Delete has no effect.",
+		Create:      resourceGeoserverLoggingCreate,
+		Read:        resourceGeoserverLoggingRead,
+		Update:      resourceGeoserverLoggingUpdate,
+		Delete:      resourceGeoserverLoggingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGeoserverLoggingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"level": {
+				Type:     schema.TypeString,
+				Required: true,
+				// Older Geoserver report the profile with the extension of its file
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return loggingProfileName(old) == loggingProfileName(new)
+				},
+				Description: "Logging profile, e.g. DEFAULT_LOGGING, PRODUCTION_LOGGING, QUIET_LOGGING, VERBOSE_LOGGING, GEOSERVER_DEVELOPER_LOGGING, GEOTOOLS_DEVELOPER_LOGGING, or the name of the custom profile.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "logs/geoserver.log",
+				Description: "Location of the log file, relative to the data directory. Default value is logs/geoserver.log.",
+			},
+			"stdout_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Log to the standard output as well. Default value is true.",
+			},
+			"custom_profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Content of a custom log4j profile. It is uploaded as logs/<level>.xml in the data directory before the level is applied. The file is kept when the resource is destroyed.",
+			},
+			"request_logging_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Log the incoming requests. Left as is when not set.",
+			},
+			"request_logging_bodies": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Log the bodies of the incoming requests. Left as is when not set.",
+			},
+			"request_logging_headers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Log the headers of the incoming requests. Left as is when not set.",
+			},
+		},
+	}
+}
+
+// loggingProfileName strips the extension of the profile file, e.g. DEFAULT_LOGGING.properties
+func loggingProfileName(level string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(level, ".properties"), ".xml")
+}
+
+func resourceGeoserverLoggingCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Configuring Geoserver Logging")
+
+	err := resourceGeoserverLoggingUpdate(d, meta)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("logging")
+
+	return resourceGeoserverLoggingRead(d, meta)
+}
+
+func resourceGeoserverLoggingRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Geoserver Logging: %s", d.Id())
+
+	client := meta.(*Config).RestClient()
+
+	var body loggingBody
+	err := client.GetJSON("/logging.json", &body)
+	if err != nil {
+		return err
+	}
+
+	logging := body.Logging
+	d.Set("level", logging.Level)
+	d.Set("location", logging.Location)
+	d.Set("stdout_logging", logging.StdOutLogging)
+	if logging.RequestLoggingEnabled != nil {
+		d.Set("request_logging_enabled", *logging.RequestLoggingEnabled)
+	}
+	if logging.RequestLoggingBodies != nil {
+		d.Set("request_logging_bodies", *logging.RequestLoggingBodies)
+	}
+	if logging.RequestLoggingHeaders != nil {
+		d.Set("request_logging_headers", *logging.RequestLoggingHeaders)
+	}
+
+	if d.Get("custom_profile").(string) != "" {
+		geoserverClient := meta.(*Config).GeoserverClient()
+
+		profile, err := geoserverClient.GetResource(fmt.Sprintf("logs/%s", loggingProfileName(logging.Level)), "xml")
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return err
+		}
+		d.Set("custom_profile", profile)
+	}
+
+	return nil
+}
+
+func resourceGeoserverLoggingUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Geoserver Logging: %s", d.Id())
+
+	level := loggingProfileName(d.Get("level").(string))
+
+	if profile := d.Get("custom_profile").(string); profile != "" {
+		geoserverClient := meta.(*Config).GeoserverClient()
+
+		// Same upload as geoserver_resource, the profile has to exist before the level refers to it
+		err := geoserverClient.UpdateResource(fmt.Sprintf("logs/%s", level), "xml", profile)
+		if err != nil {
+			return err
+		}
+	}
+
+	logging := restLogging{
+		Level:         level,
+		Location:      d.Get("location").(string),
+		StdOutLogging: d.Get("stdout_logging").(bool),
+	}
+	if v, ok := d.GetOkExists("request_logging_enabled"); ok {
+		enabled := v.(bool)
+		logging.RequestLoggingEnabled = &enabled
+	}
+	if v, ok := d.GetOkExists("request_logging_bodies"); ok {
+		bodies := v.(bool)
+		logging.RequestLoggingBodies = &bodies
+	}
+	if v, ok := d.GetOkExists("request_logging_headers"); ok {
+		headers := v.(bool)
+		logging.RequestLoggingHeaders = &headers
+	}
+
+	client := meta.(*Config).RestClient()
+
+	return client.SendJSON(http.MethodPut, "/logging", &loggingBody{Logging: logging})
+}
+
+func resourceGeoserverLoggingDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Logging cannot be deleted. Skipping action")
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceGeoserverLoggingImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.SetId("logging")
+
+	log.Printf("[INFO] Importing Geoserver Logging")
+
+	err := resourceGeoserverLoggingRead(d, meta)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
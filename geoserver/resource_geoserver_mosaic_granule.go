@@ -0,0 +1,255 @@
+package geoserver
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// mosaicGranules is the GeoJSON representation of an ImageMosaic index
+type mosaicGranules struct {
+	Features []struct {
+		ID         string                 `json:"id"`
+		Properties map[string]interface{} `json:"properties"`
+	} `json:"features"`
+}
+
+func resourceGeoserverMosaicGranule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGeoserverMosaicGranuleCreate,
+		Read:   resourceGeoserverMosaicGranuleRead,
+		Delete: resourceGeoserverMosaicGranuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGeoserverMosaicGranuleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the workspace owning the ImageMosaic store. Used to compute the id of the resource.",
+			},
+			"coveragestore_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the ImageMosaic coverage store. Used to compute the id of the resource.",
+			},
+			"coverage_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the coverage whose index holds the granules. Used to compute the id of the resource.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path of the file or directory to harvest, as seen by the Geoserver server. It must lie in the mosaic directory when the index stores relative locations. Used to compute the id of the resource.",
+			},
+			"locations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Locations of the granules harvested from path.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// mosaicGranulesPath returns the path of the granules in the index of a coverage
+func mosaicGranulesPath(workspaceName string, storeName string, coverageName string) string {
+	return fmt.Sprintf("/workspaces/%s/coveragestores/%s/coverages/%s/index/granules", workspaceName, storeName, coverageName)
+}
+
+// mosaicGranuleLikeEscaper escapes the wildcards and the escape character of a CQL LIKE pattern
+var mosaicGranuleLikeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// mosaicGranuleFilter matches the granules harvested from a file or a directory, whose location is one of
+// the given ones, absolute or relative to the mosaic directory depending on the index
+func mosaicGranuleFilter(locations []string) string {
+	var conditions []string
+	for _, location := range locations {
+		location = strings.TrimSuffix(location, "/")
+		conditions = append(conditions,
+			fmt.Sprintf("location = '%s'", strings.ReplaceAll(location, "'", "''")),
+			fmt.Sprintf("location LIKE '%s/%%'", strings.ReplaceAll(mosaicGranuleLikeEscaper.Replace(location), "'", "''")),
+		)
+	}
+	return strings.Join(conditions, " OR ")
+}
+
+// mosaicGranuleLocations returns the locations a granule harvested from path may have in the index: the path
+// itself, and the path relative to the mosaic directory when it lies in it
+func mosaicGranuleLocations(meta interface{}, workspaceName string, storeName string, path string) ([]string, error) {
+	client := meta.(*Config).RestClient()
+
+	var store struct {
+		CoverageStore struct {
+			URL string `json:"url"`
+		} `json:"coverageStore"`
+	}
+	err := client.GetJSON(fmt.Sprintf("/workspaces/%s/coveragestores/%s.json", workspaceName, storeName), &store)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return nil, err
+	}
+
+	locations := []string{path}
+
+	// A mosaic directory relative to the data directory cannot be compared with the path
+	storeURL, err := url.Parse(store.CoverageStore.URL)
+	if err != nil || storeURL.Scheme != "file" || !strings.HasPrefix(storeURL.Path, "/") {
+		return locations, nil
+	}
+	directory := strings.TrimSuffix(storeURL.Path, "/") + "/"
+	if relative := strings.TrimPrefix(path, directory); relative != path && relative != "" {
+		locations = append(locations, relative)
+	}
+
+	return locations, nil
+}
+
+// parseMosaicGranuleID splits the <workspace>/<store>/<coverage>/<path> id of a granule
+func parseMosaicGranuleID(id string) (string, string, string, string, error) {
+	splittedID := strings.SplitN(id, "/", 4)
+	if len(splittedID) != 4 {
+		return "", "", "", "", fmt.Errorf("invalid id %q, expected <workspace>/<store>/<coverage>/<path>", id)
+	}
+
+	return splittedID[0], splittedID[1], splittedID[2], splittedID[3], nil
+}
+
+// findMosaicGranules returns the granules of the index harvested from path
+func findMosaicGranules(meta interface{}, workspaceName string, storeName string, coverageName string, path string) (*mosaicGranules, error) {
+	client := meta.(*Config).RestClient()
+
+	locations, err := mosaicGranuleLocations(meta, workspaceName, storeName, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var granules mosaicGranules
+	filter := url.QueryEscape(mosaicGranuleFilter(locations))
+	err = client.GetJSON(fmt.Sprintf("%s.json?filter=%s", mosaicGranulesPath(workspaceName, storeName, coverageName), filter), &granules)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return nil, err
+	}
+
+	return &granules, nil
+}
+
+func resourceGeoserverMosaicGranuleCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Harvesting Geoserver Mosaic Granule: %s", d.Get("path").(string))
+
+	client := meta.(*Config).RestClient()
+
+	workspaceName := d.Get("workspace_name").(string)
+	storeName := d.Get("coveragestore_name").(string)
+	coverageName := d.Get("coverage_name").(string)
+	path := d.Get("path").(string)
+
+	err := client.SendText(http.MethodPost, fmt.Sprintf("/workspaces/%s/coveragestores/%s/external.imagemosaic", workspaceName, storeName), path)
+	if err != nil {
+		return err
+	}
+
+	// A granule that cannot be found back would be dropped from the state by the next refresh
+	granules, err := findMosaicGranules(meta, workspaceName, storeName, coverageName, path)
+	if err != nil {
+		return err
+	}
+	if len(granules.Features) == 0 {
+		return fmt.Errorf("no granule harvested from %q found in the index of %s/%s/%s, the locations of the index must be absolute or relative to the mosaic directory", path, workspaceName, storeName, coverageName)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", workspaceName, storeName, coverageName, path))
+
+	return resourceGeoserverMosaicGranuleRead(d, meta)
+}
+
+func resourceGeoserverMosaicGranuleRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Geoserver Mosaic Granule: %s", d.Id())
+
+	workspaceName, storeName, coverageName, path, err := parseMosaicGranuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	granules, err := findMosaicGranules(meta, workspaceName, storeName, coverageName, path)
+	if err != nil {
+		return err
+	}
+
+	if len(granules.Features) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	var locations []string
+	for _, granule := range granules.Features {
+		locations = append(locations, fmt.Sprint(granule.Properties["location"]))
+	}
+
+	d.Set("workspace_name", workspaceName)
+	d.Set("coveragestore_name", storeName)
+	d.Set("coverage_name", coverageName)
+	d.Set("path", path)
+	d.Set("locations", locations)
+
+	return nil
+}
+
+func resourceGeoserverMosaicGranuleDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Removing Geoserver Mosaic Granule: %s", d.Id())
+
+	workspaceName, storeName, coverageName, path, err := parseMosaicGranuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Config).RestClient()
+
+	locations, err := mosaicGranuleLocations(meta, workspaceName, storeName, path)
+	if err != nil {
+		return err
+	}
+
+	filter := url.QueryEscape(mosaicGranuleFilter(locations))
+	err = client.Delete(fmt.Sprintf("%s?filter=%s", mosaicGranulesPath(workspaceName, storeName, coverageName), filter))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceGeoserverMosaicGranuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	workspaceName, storeName, coverageName, path, err := parseMosaicGranuleID(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(d.Id())
+	d.Set("workspace_name", workspaceName)
+	d.Set("coveragestore_name", storeName)
+	d.Set("coverage_name", coverageName)
+	d.Set("path", path)
+
+	log.Printf("[INFO] Importing Geoserver Mosaic Granule `%s` in coverage `%s`", path, coverageName)
+
+	err = resourceGeoserverMosaicGranuleRead(d, meta)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,52 @@
+package geoserver
+
+import (
+	"testing"
+)
+
+func TestMosaicGranuleFilter(t *testing.T) {
+	cases := []struct {
+		name      string
+		locations []string
+		expected  string
+	}{
+		{
+			"absolute path",
+			[]string{"/data/mosaic/2024/"},
+			`location = '/data/mosaic/2024' OR location LIKE '/data/mosaic/2024/%'`,
+		},
+		{
+			"quotes and wildcards",
+			[]string{"/data/o'brien_100%"},
+			`location = '/data/o''brien_100%' OR location LIKE '/data/o''brien\_100\%/%'`,
+		},
+		{
+			"relative location",
+			[]string{"/data/mosaic/2024", "2024"},
+			`location = '/data/mosaic/2024' OR location LIKE '/data/mosaic/2024/%' OR location = '2024' OR location LIKE '2024/%'`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if filter := mosaicGranuleFilter(c.locations); filter != c.expected {
+				t.Errorf("expected %s, got %s", c.expected, filter)
+			}
+		})
+	}
+}
+
+func TestParseMosaicGranuleID(t *testing.T) {
+	workspaceName, storeName, coverageName, path, err := parseMosaicGranuleID("ws/store/coverage//data/mosaic/a.tif")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if workspaceName != "ws" || storeName != "store" || coverageName != "coverage" || path != "/data/mosaic/a.tif" {
+		t.Errorf("unexpected id parts %q, %q, %q, %q", workspaceName, storeName, coverageName, path)
+	}
+
+	_, _, _, _, err = parseMosaicGranuleID("ws/store")
+	if err == nil {
+		t.Error("expected an error for an id without coverage and path")
+	}
+}
@@ -0,0 +1,121 @@
+package geoserver
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceGeoserverRole() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage a role of a role service. The parent role and the properties of the role cannot be managed: the Geoserver REST API only creates, lists, assigns and deletes roles, and has no endpoint for them. Set them in the Geoserver web administration, where they are kept as the resource does not read them.",
+		Create:      resourceGeoserverRoleCreate,
+		Read:        resourceGeoserverRoleRead,
+		Delete:      resourceGeoserverRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGeoserverRoleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the role service. If empty the default geoserver one will be used. Used to compute the id of the resource.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role, e.g. ROLE_EDITOR. Used to compute the id of the resource.",
+			},
+		},
+	}
+}
+
+func resourceGeoserverRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Creating Geoserver Role: %s", d.Get("name").(string))
+
+	serviceName := d.Get("service_name").(string)
+	roleName := d.Get("name").(string)
+
+	err := postRole(meta, fmt.Sprintf("%s/role/%s", rolesPath(serviceName), roleName))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, roleName))
+
+	return resourceGeoserverRoleRead(d, meta)
+}
+
+func resourceGeoserverRoleRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Geoserver Role: %s", d.Id())
+
+	splittedID := strings.Split(d.Id(), "/")
+	if len(splittedID) != 2 {
+		return fmt.Errorf("invalid id %q, expected <service>/<role> with an empty service for the default one", d.Id())
+	}
+	serviceName := splittedID[0]
+	roleName := splittedID[1]
+
+	found, err := listsRole(meta, rolesPath(serviceName), roleName)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+
+	if err != nil || !found {
+		d.SetId("")
+		return nil
+	}
+	d.Set("service_name", serviceName)
+	d.Set("name", roleName)
+
+	return nil
+}
+
+func resourceGeoserverRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Geoserver Role: %s", d.Id())
+
+	splittedID := strings.Split(d.Id(), "/")
+	if len(splittedID) != 2 {
+		return fmt.Errorf("invalid id %q, expected <service>/<role> with an empty service for the default one", d.Id())
+	}
+	serviceName := splittedID[0]
+	roleName := splittedID[1]
+
+	client := meta.(*Config).RestClient()
+
+	err := client.Delete(fmt.Sprintf("%s/role/%s", rolesPath(serviceName), roleName))
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceGeoserverRoleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	splittedID := strings.Split(d.Id(), "/")
+	if len(splittedID) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("invalid id %q, expected <service>/<role> with an empty service for the default one", d.Id())
+	}
+	serviceName := splittedID[0]
+	roleName := splittedID[1]
+
+	d.SetId(d.Id())
+	d.Set("name", roleName)
+	d.Set("service_name", serviceName)
+
+	log.Printf("[INFO] Importing Geoserver Role `%s` in service `%s`", roleName, serviceName)
+
+	err := resourceGeoserverRoleRead(d, meta)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
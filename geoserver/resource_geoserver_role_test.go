@@ -0,0 +1,27 @@
+package geoserver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestResourceGeoserverRoleInvalidID(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceGeoserverRole().Schema, map[string]interface{}{})
+	d.SetId("ROLE_EDITOR")
+
+	err := resourceGeoserverRoleRead(d, &Config{})
+	if err == nil {
+		t.Error("expected an error for an id without service")
+	}
+}
+
+func TestRoleAssignmentInvalidID(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceGeoserverUserRole().Schema, map[string]interface{}{})
+	d.SetId("/editor")
+
+	err := userRoleAssignment.read(d, &Config{})
+	if err == nil {
+		t.Error("expected an error for an id without role")
+	}
+}
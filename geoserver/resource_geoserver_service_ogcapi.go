@@ -0,0 +1,186 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type restOgcapiFeatures struct {
+	restService
+	// The limit and the conformance classes are only sent when set, to keep the Geoserver defaults
+	MaxFeaturesPerPage int                            `json:"maxFeaturesPerPage,omitempty"`
+	Conformance        *restOgcapiFeaturesConformance `json:"conformance,omitempty"`
+}
+
+type restOgcapiFeaturesConformance struct {
+	CQL2Text       bool `json:"cql2Text"`
+	CQL2JSON       bool `json:"cql2JSON"`
+	SortBy         bool `json:"sortBy"`
+	CRSByReference bool `json:"crsByReference"`
+}
+
+var ogcapiFeaturesService = &ogcService{
+	name:  "features",
+	label: "OGC API Features",
+	expand: func(d *schema.ResourceData, service restService, current json.RawMessage) interface{} {
+		features := &restOgcapiFeatures{
+			restService:        service,
+			MaxFeaturesPerPage: d.Get("max_features_per_page").(int),
+		}
+
+		// The conformance classes are left as is without the block
+		if blocks := d.Get("conformance").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+			v := blocks[0].(map[string]interface{})
+			features.Conformance = &restOgcapiFeaturesConformance{
+				CQL2Text:       v["cql2_text"].(bool),
+				CQL2JSON:       v["cql2_json"].(bool),
+				SortBy:         v["sorting"].(bool),
+				CRSByReference: v["crs_by_reference"].(bool),
+			}
+		}
+
+		return features
+	},
+	flatten: func(d *schema.ResourceData, raw json.RawMessage) error {
+		var features restOgcapiFeatures
+		err := json.Unmarshal(raw, &features)
+		if err != nil {
+			return fmt.Errorf("unable to decode the OGC API Features service configuration: %s", err)
+		}
+
+		d.Set("max_features_per_page", features.MaxFeaturesPerPage)
+
+		// The conformance classes are only read back when managed, a missing flag being disabled
+		if len(d.Get("conformance").([]interface{})) > 0 {
+			conformance := restOgcapiFeaturesConformance{}
+			if features.Conformance != nil {
+				conformance = *features.Conformance
+			}
+			d.Set("conformance", []map[string]interface{}{
+				{
+					"cql2_text":        conformance.CQL2Text,
+					"cql2_json":        conformance.CQL2JSON,
+					"sorting":          conformance.SortBy,
+					"crs_by_reference": conformance.CRSByReference,
+				},
+			})
+		}
+
+		// OGC API Features publishes the feature types of the WFS service of the same scope
+		d.Set("wfs_service_id", strings.Replace(d.Id(), "features_", "wfs_", 1))
+
+		return nil
+	},
+}
+
+// ogcapiService builds the services of the OGC API family having no setting besides the shared ones
+func ogcapiService(name string, label string) *ogcService {
+	return &ogcService{
+		name:  name,
+		label: label,
+		expand: func(d *schema.ResourceData, service restService, current json.RawMessage) interface{} {
+			return &service
+		},
+		flatten: func(d *schema.ResourceData, raw json.RawMessage) error {
+			return nil
+		},
+	}
+}
+
+var ogcapiTilesService = ogcapiService("tiles", "OGC API Tiles")
+
+var ogcapiStylesService = ogcapiService("styles", "OGC API Styles")
+
+func resourceGeoServerServiceOgcapiFeatures() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage global or per-workspace OGC API Features configuration. The configuration is a singleton so Create is similar to Update and Delete has no effect.",
+		Create:      ogcapiFeaturesService.create,
+		Read:        ogcapiFeaturesService.read,
+		Update:      ogcapiFeaturesService.update,
+		Delete:      ogcapiFeaturesService.delete,
+		Importer: &schema.ResourceImporter{
+			State: ogcapiFeaturesService.importState,
+		},
+
+		Schema: serviceSchema("OGC API Features", map[string]*schema.Schema{
+			"max_features_per_page": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of features of a page, i.e. the maximum value of the limit parameter. The Geoserver value is kept when not set.",
+			},
+			"conformance": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Conformance classes enabled on the service. The Geoserver values are kept when the block is absent, and all of them are managed otherwise.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cql2_text": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Enable the CQL2 text filter conformance class. Default value is false.",
+						},
+						"cql2_json": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Enable the CQL2 JSON filter conformance class. Default value is false.",
+						},
+						"sorting": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Enable the sorting (sortby parameter) conformance class. Default value is false.",
+						},
+						"crs_by_reference": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Enable the coordinate reference systems by reference conformance class. Default value is false.",
+						},
+					},
+				},
+			},
+			"wfs_service_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the geoserver_service_wfs of the same scope. OGC API Features publishes the feature types of the WFS service, whose max_features also bounds the pages.",
+			},
+		}),
+	}
+}
+
+func resourceGeoServerServiceOgcapiTiles() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage global or per-workspace OGC API Tiles configuration. The configuration is a singleton so Create is similar to Update and Delete has no effect.",
+		Create:      ogcapiTilesService.create,
+		Read:        ogcapiTilesService.read,
+		Update:      ogcapiTilesService.update,
+		Delete:      ogcapiTilesService.delete,
+		Importer: &schema.ResourceImporter{
+			State: ogcapiTilesService.importState,
+		},
+
+		Schema: serviceSchema("OGC API Tiles", map[string]*schema.Schema{}),
+	}
+}
+
+func resourceGeoServerServiceOgcapiStyles() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage global or per-workspace OGC API Styles configuration. The configuration is a singleton so Create is similar to Update and Delete has no effect.",
+		Create:      ogcapiStylesService.create,
+		Read:        ogcapiStylesService.read,
+		Update:      ogcapiStylesService.update,
+		Delete:      ogcapiStylesService.delete,
+		Importer: &schema.ResourceImporter{
+			State: ogcapiStylesService.importState,
+		},
+
+		Schema: serviceSchema("OGC API Styles", map[string]*schema.Schema{}),
+	}
+}
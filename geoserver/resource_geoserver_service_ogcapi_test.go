@@ -0,0 +1,62 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestOgcapiFeaturesServiceConformance(t *testing.T) {
+	// Without the block, the conformance classes are left as is
+	d := schema.TestResourceDataRaw(t, resourceGeoServerServiceOgcapiFeatures().Schema, map[string]interface{}{})
+	sent, err := json.Marshal(ogcapiFeaturesService.expand(d, restService{}, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if strings.Contains(string(sent), "conformance") {
+		t.Errorf("expected no conformance in %s", sent)
+	}
+
+	// A disabled class is sent as such
+	d = schema.TestResourceDataRaw(t, resourceGeoServerServiceOgcapiFeatures().Schema, map[string]interface{}{
+		"conformance": []interface{}{
+			map[string]interface{}{"cql2_text": true},
+		},
+	})
+	sent, err = json.Marshal(ogcapiFeaturesService.expand(d, restService{}, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := `"conformance":{"cql2Text":true,"cql2JSON":false,"sortBy":false,"crsByReference":false}`
+	if !strings.Contains(string(sent), expected) {
+		t.Errorf("expected %s in %s", expected, sent)
+	}
+
+	received := `{"conformance":{"cql2Text":true,"sortBy":true}}`
+	err = ogcapiFeaturesService.flatten(d, json.RawMessage(received))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for attribute, expected := range map[string]bool{
+		"conformance.0.cql2_text":        true,
+		"conformance.0.cql2_json":        false,
+		"conformance.0.sorting":          true,
+		"conformance.0.crs_by_reference": false,
+	} {
+		if value := d.Get(attribute).(bool); value != expected {
+			t.Errorf("expected %s to be %t, got %t", attribute, expected, value)
+		}
+	}
+
+	// The conformance classes are not read back when not managed
+	d = schema.TestResourceDataRaw(t, resourceGeoServerServiceOgcapiFeatures().Schema, map[string]interface{}{})
+	err = ogcapiFeaturesService.flatten(d, json.RawMessage(received))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if blocks := d.Get("conformance").([]interface{}); len(blocks) != 0 {
+		t.Errorf("expected no conformance block, got %v", blocks)
+	}
+}
@@ -0,0 +1,165 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type restVersions struct {
+	Versions restList[restVersion] `json:"org.geotools.util.Version"`
+}
+
+type restVersion struct {
+	Version string `json:"version"`
+}
+
+type restWcs struct {
+	restService
+	Versions                       *restVersions `json:"versions,omitempty"`
+	MaxInputMemory                 int           `json:"maxInputMemory"`
+	MaxOutputMemory                int           `json:"maxOutputMemory"`
+	LatLon                         bool          `json:"latLon"`
+	GMLPrefixing                   bool          `json:"gmlPrefixing"`
+	DefaultDeflateCompressionLevel int           `json:"defaultDeflateCompressionLevel"`
+	OverviewPolicy                 string        `json:"overviewPolicy"`
+	SubsamplingEnabled             bool          `json:"subsamplingEnabled"`
+}
+
+var wcsService = &ogcService{
+	name:        "wcs",
+	label:       "WCS",
+	serviceName: "WCS",
+	expand: func(d *schema.ResourceData, service restService, current json.RawMessage) interface{} {
+		// No versions are sent when empty, to keep the ones of Geoserver
+		var versions *restVersions
+		for _, value := range d.Get("supported_versions").([]interface{}) {
+			if versions == nil {
+				versions = &restVersions{}
+			}
+			versions.Versions = append(versions.Versions, restVersion{Version: value.(string)})
+		}
+
+		return &restWcs{
+			restService:                    service,
+			Versions:                       versions,
+			MaxInputMemory:                 d.Get("max_input_memory").(int),
+			MaxOutputMemory:                d.Get("max_output_memory").(int),
+			LatLon:                         d.Get("lat_lon").(bool),
+			GMLPrefixing:                   d.Get("gml_prefixing").(bool),
+			DefaultDeflateCompressionLevel: d.Get("default_deflate_level").(int),
+			OverviewPolicy:                 d.Get("overview_policy").(string),
+			SubsamplingEnabled:             d.Get("subsampling_enabled").(bool),
+		}
+	},
+	flatten: func(d *schema.ResourceData, raw json.RawMessage) error {
+		var wcs restWcs
+		err := json.Unmarshal(raw, &wcs)
+		if err != nil {
+			return fmt.Errorf("unable to decode the WCS service configuration: %s", err)
+		}
+
+		var supportedVersions []string
+		if wcs.Versions != nil {
+			for _, value := range wcs.Versions.Versions {
+				supportedVersions = append(supportedVersions, value.Version)
+			}
+		}
+		d.Set("supported_versions", supportedVersions)
+
+		d.Set("max_input_memory", wcs.MaxInputMemory)
+		d.Set("max_output_memory", wcs.MaxOutputMemory)
+		d.Set("lat_lon", wcs.LatLon)
+		d.Set("gml_prefixing", wcs.GMLPrefixing)
+		d.Set("default_deflate_level", wcs.DefaultDeflateCompressionLevel)
+		d.Set("overview_policy", wcs.OverviewPolicy)
+		d.Set("subsampling_enabled", wcs.SubsamplingEnabled)
+
+		return nil
+	},
+}
+
+func resourceGeoServerServiceWcs() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage global or per-workspace WCS configuration. The configuration is a singleton so Create is similar to Update and Delete has no effect.",
+		Create:      wcsService.create,
+		Read:        wcsService.read,
+		Update:      wcsService.update,
+		Delete:      wcsService.delete,
+		Importer: &schema.ResourceImporter{
+			State: wcsService.importState,
+		},
+
+		Schema: serviceSchema("WCS", map[string]*schema.Schema{
+			"supported_versions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Versions of the protocol supported by the service, e.g. 1.0.0, 1.1.1, 2.0.1. The versions of Geoserver are kept when empty.",
+			},
+			"max_input_memory": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum amount of data in KB read to answer a request. Default value is 0 (no limit).",
+			},
+			"max_output_memory": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum size in KB of the coverage returned by a request. Default value is 0 (no limit).",
+			},
+			"lat_lon": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Override the axis order of EPSG:4326 to lat/lon in the WCS 1.1 responses. Default value is false.",
+			},
+			"gml_prefixing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Prefix the identifiers of the GML elements of the WCS 2.0 responses. Default value is false.",
+			},
+			"default_deflate_level": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     9,
+				Description: "Default compression level, from 1 to 9, of the deflate compressed outputs. Default value is 9.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(int)
+					if v < 1 || v > 9 {
+						errs = append(errs, fmt.Errorf("%q must be one between 1 and 9, got: %d", key, v))
+					}
+					return
+				},
+			},
+			"overview_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "IGNORE",
+				Description: "Policy choosing the overview read to answer a request. Authorized values are : IGNORE, NEAREST, QUALITY, SPEED. Default value is IGNORE.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					allowed_values := []string{"IGNORE", "NEAREST", "QUALITY", "SPEED"}
+					if !slices.Contains(allowed_values, v) {
+						errs = append(errs, fmt.Errorf("%q must be one of this values %q, got: %q", key, strings.Join(allowed_values, ","), v))
+					}
+					return
+				},
+			},
+			"subsampling_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Use the subsampling of the readers when the resolution of the request allows it. Default value is true.",
+			},
+		}),
+	}
+}
@@ -0,0 +1,219 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type restWfs struct {
+	restService
+	ServiceLevel                  string      `json:"serviceLevel"`
+	MaxFeatures                   int         `json:"maxFeatures"`
+	MaxNumberOfFeaturesForPreview int         `json:"maxNumberOfFeaturesForPreview"`
+	FeatureBounding               bool        `json:"featureBounding"`
+	CanonicalSchemaLocation       bool        `json:"canonicalSchemaLocation"`
+	EncodeFeatureMember           bool        `json:"encodeFeatureMember"`
+	HitsIgnoreMaxFeatures         bool        `json:"hitsIgnoreMaxFeatures"`
+	GML                           restWfsGMLs `json:"gml"`
+}
+
+type restWfsGMLs struct {
+	Entries restList[restWfsGMLEntry] `json:"entry"`
+}
+
+type restWfsGMLEntry struct {
+	Version string     `json:"version"`
+	GML     restWfsGML `json:"gml"`
+}
+
+type restWfsGML struct {
+	SrsNameStyle          restList[string] `json:"srsNameStyle"`
+	OverrideGMLAttributes bool             `json:"overrideGMLAttributes"`
+}
+
+// wfsGMLVersions maps the GML attributes prefixes onto the WFSInfo.Version keys of the gml map of WFSInfo
+var wfsGMLVersions = []struct {
+	prefix  string
+	version string
+}{
+	{"gml2", "V_10"},
+	{"gml3", "V_11"},
+	{"gml32", "V_20"},
+}
+
+var wfsService = &ogcService{
+	name:        "wfs",
+	label:       "WFS",
+	serviceName: "WFS",
+	expand: func(d *schema.ResourceData, service restService, current json.RawMessage) interface{} {
+		wfs := &restWfs{
+			restService:                   service,
+			ServiceLevel:                  d.Get("service_level").(string),
+			MaxFeatures:                   d.Get("max_features").(int),
+			MaxNumberOfFeaturesForPreview: d.Get("max_number_of_features_for_preview").(int),
+			FeatureBounding:               d.Get("feature_bounding").(bool),
+			CanonicalSchemaLocation:       d.Get("canonical_schema_location").(bool),
+			EncodeFeatureMember:           d.Get("encode_feature_member").(bool),
+			HitsIgnoreMaxFeatures:         d.Get("hits_ignore_max_features").(bool),
+		}
+
+		for _, gml := range wfsGMLVersions {
+			wfs.GML.Entries = append(wfs.GML.Entries, restWfsGMLEntry{
+				Version: gml.version,
+				GML: restWfsGML{
+					SrsNameStyle:          restList[string]{d.Get(fmt.Sprintf("%s_srs_name_style", gml.prefix)).(string)},
+					OverrideGMLAttributes: d.Get(fmt.Sprintf("%s_override_attributes", gml.prefix)).(bool),
+				},
+			})
+		}
+
+		return wfs
+	},
+	flatten: func(d *schema.ResourceData, raw json.RawMessage) error {
+		var wfs restWfs
+		err := json.Unmarshal(raw, &wfs)
+		if err != nil {
+			return fmt.Errorf("unable to decode the WFS service configuration: %s", err)
+		}
+
+		d.Set("service_level", wfs.ServiceLevel)
+		d.Set("max_features", wfs.MaxFeatures)
+		d.Set("max_number_of_features_for_preview", wfs.MaxNumberOfFeaturesForPreview)
+		d.Set("feature_bounding", wfs.FeatureBounding)
+		d.Set("canonical_schema_location", wfs.CanonicalSchemaLocation)
+		d.Set("encode_feature_member", wfs.EncodeFeatureMember)
+		d.Set("hits_ignore_max_features", wfs.HitsIgnoreMaxFeatures)
+
+		for _, entry := range wfs.GML.Entries {
+			for _, gml := range wfsGMLVersions {
+				if entry.Version != gml.version {
+					continue
+				}
+				if len(entry.GML.SrsNameStyle) > 0 {
+					d.Set(fmt.Sprintf("%s_srs_name_style", gml.prefix), entry.GML.SrsNameStyle[0])
+				}
+				d.Set(fmt.Sprintf("%s_override_attributes", gml.prefix), entry.GML.OverrideGMLAttributes)
+			}
+		}
+
+		return nil
+	},
+}
+
+func resourceGeoServerServiceWfs() *schema.Resource {
+	srsNameStyleValidateFunc := func(val interface{}, key string) (warns []string, errs []error) {
+		v := val.(string)
+		allowed_values := []string{"NORMAL", "XML", "URN", "URN2", "URL"}
+		if !slices.Contains(allowed_values, v) {
+			errs = append(errs, fmt.Errorf("%q must be one of this values %q, got: %q", key, strings.Join(allowed_values, ","), v))
+		}
+		return
+	}
+
+	return &schema.Resource{
+		Description: "Manage global or per-workspace WFS configuration. The configuration is a singleton so Create is similar to Update and Delete has no effect.",
+		Create:      wfsService.create,
+		Read:        wfsService.read,
+		Update:      wfsService.update,
+		Delete:      wfsService.delete,
+		Importer: &schema.ResourceImporter{
+			State: wfsService.importState,
+		},
+
+		Schema: serviceSchema("WFS", map[string]*schema.Schema{
+			"service_level": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "COMPLETE",
+				Description: "Operations offered by the service, holding the transaction settings: BASIC is read only, TRANSACTIONAL enables the transactions, COMPLETE adds the feature locking. Authorized values are : BASIC, TRANSACTIONAL, COMPLETE. Default value is COMPLETE.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					allowed_values := []string{"BASIC", "TRANSACTIONAL", "COMPLETE"}
+					if !slices.Contains(allowed_values, v) {
+						errs = append(errs, fmt.Errorf("%q must be one of this values %q, got: %q", key, strings.Join(allowed_values, ","), v))
+					}
+					return
+				},
+			},
+			"max_features": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1000000,
+				Description: "Maximum number of features returned by a GetFeature request, bounding the count of the pages. Default value is 1000000.",
+			},
+			"max_number_of_features_for_preview": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     50,
+				Description: "Default count of the features requested by the layer preview. Default value is 50.",
+			},
+			"feature_bounding": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Return the bounding box of each feature. Default value is false.",
+			},
+			"canonical_schema_location": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reference the canonical location of the schemas instead of DescribeFeatureType requests. Default value is false.",
+			},
+			"encode_feature_member": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Encode the features of the GML3 responses in featureMember elements instead of a featureMembers one. Default value is false.",
+			},
+			"hits_ignore_max_features": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Count all the features in the hits responses (resultType=hits), ignoring max_features. Default value is false.",
+			},
+			"gml2_srs_name_style": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "XML",
+				Description:  "Style of the SRS names of the GML2 responses (WFS 1.0). Authorized values are : NORMAL, XML, URN, URN2, URL. Default value is XML.",
+				ValidateFunc: srsNameStyleValidateFunc,
+			},
+			"gml2_override_attributes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Omit the GML attributes (name, description, boundedBy) of the GML2 responses. Default value is true.",
+			},
+			"gml3_srs_name_style": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "URN",
+				Description:  "Style of the SRS names of the GML3 responses (WFS 1.1). Authorized values are : NORMAL, XML, URN, URN2, URL. Default value is URN.",
+				ValidateFunc: srsNameStyleValidateFunc,
+			},
+			"gml3_override_attributes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Omit the GML attributes (name, description, boundedBy) of the GML3 responses. Default value is false.",
+			},
+			"gml32_srs_name_style": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "URN2",
+				Description:  "Style of the SRS names of the GML3.2 responses (WFS 2.0). Authorized values are : NORMAL, XML, URN, URN2, URL. Default value is URN2.",
+				ValidateFunc: srsNameStyleValidateFunc,
+			},
+			"gml32_override_attributes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Omit the GML attributes (name, description, boundedBy) of the GML3.2 responses. Default value is false.",
+			},
+		}),
+	}
+}
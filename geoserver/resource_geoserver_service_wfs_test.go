@@ -0,0 +1,42 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestWfsServiceGML(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceGeoServerServiceWfs().Schema, map[string]interface{}{
+		"gml3_srs_name_style": "URL",
+	})
+
+	sent, err := json.Marshal(wfsService.expand(d, restService{}, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, version := range []string{`"version":"V_10"`, `"version":"V_11"`, `"version":"V_20"`} {
+		if !strings.Contains(string(sent), version) {
+			t.Errorf("expected %s in %s", version, sent)
+		}
+	}
+
+	received := `{"gml":{"entry":[` +
+		`{"version":"V_10","gml":{"srsNameStyle":"XML","overrideGMLAttributes":true}},` +
+		`{"version":"V_11","gml":{"srsNameStyle":["NORMAL"],"overrideGMLAttributes":true}},` +
+		`{"version":"V_20","gml":{"srsNameStyle":"URN2","overrideGMLAttributes":false}}` +
+		`]}}`
+	d = schema.TestResourceDataRaw(t, resourceGeoServerServiceWfs().Schema, map[string]interface{}{})
+	err = wfsService.flatten(d, json.RawMessage(received))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if style := d.Get("gml3_srs_name_style").(string); style != "NORMAL" {
+		t.Errorf("expected the GML3 style to be NORMAL, got %q", style)
+	}
+	if !d.Get("gml3_override_attributes").(bool) {
+		t.Error("expected the GML3 attributes to be overridden")
+	}
+}
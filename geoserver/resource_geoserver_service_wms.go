@@ -250,10 +250,20 @@ func resourceGeoServerServiceWms() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"international_title":    internationalStringSchema("Title of the service by language, e.g. { en = \"Maps\", fr = \"Cartes\" }."),
+			"international_abstract": internationalStringSchema("Description of the service by language."),
+			"international_keywords": internationalKeywordsSchema("Keywords of the service in a given language."),
 		},
 	}
 }
 
+func wmsServicePath(workspaceName string) string {
+	if workspaceName == "" {
+		return "/services/wms/settings"
+	}
+	return fmt.Sprintf("/services/wms/workspaces/%s/settings", workspaceName)
+}
+
 func resourceGeoServerServiceWmsCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Configuring WMS Service")
 
@@ -328,6 +338,11 @@ func resourceGeoServerServiceWmsCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	err = updateInternationalDescriptors(d, meta, wmsServicePath(workspaceName), "wms")
+	if err != nil {
+		return err
+	}
+
 	if d.Get("workspace_name").(string) == "" {
 		d.SetId("wms_service_configuration")
 	} else {
@@ -404,17 +419,14 @@ func resourceGeoServerServiceWmsRead(d *schema.ResourceData, meta interface{}) e
 
 	d.Set("supported_versions", supportedVersions)
 
-	var keywords []string
-	keywords = append(keywords, wmsConfiguration.Keywords.Keywords...)
-	d.Set("keywords", keywords)
-
 	metadata := map[string]string{}
 	for _, entry := range wmsConfiguration.Metadata {
 		metadata[entry.Key] = entry.Value
 	}
 	d.Set("metadata", metadata)
 
-	return nil
+	// The keywords are read along the translations, to split the plain and the international ones
+	return readInternationalDescriptors(d, meta, wmsServicePath(workspaceName), "wms")
 }
 
 func resourceGeoServerServiceWmsDelete(d *schema.ResourceData, meta interface{}) error {
@@ -499,7 +511,7 @@ func resourceGeoServerServiceWmsUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	return nil
+	return updateInternationalDescriptors(d, meta, wmsServicePath(workspaceName), "wms")
 }
 
 func resourceGeoServerServiceWmsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
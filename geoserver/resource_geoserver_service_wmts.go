@@ -0,0 +1,35 @@
+package geoserver
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// wmtsService has no setting besides the shared ones
+var wmtsService = &ogcService{
+	name:        "wmts",
+	label:       "WMTS",
+	serviceName: "WMTS",
+	expand: func(d *schema.ResourceData, service restService, current json.RawMessage) interface{} {
+		return &service
+	},
+	flatten: func(d *schema.ResourceData, raw json.RawMessage) error {
+		return nil
+	},
+}
+
+func resourceGeoServerServiceWmts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage global or per-workspace WMTS configuration. The configuration is a singleton so Create is similar to Update and Delete has no effect.",
+		Create:      wmtsService.create,
+		Read:        wmtsService.read,
+		Update:      wmtsService.update,
+		Delete:      wmtsService.delete,
+		Importer: &schema.ResourceImporter{
+			State: wmtsService.importState,
+		},
+
+		Schema: serviceSchema("WMTS", map[string]*schema.Schema{}),
+	}
+}
@@ -0,0 +1,368 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type restWps struct {
+	restService
+	ConnectionTimeout            float64 `json:"connectionTimeout"`
+	ResourceExpirationTimeout    int     `json:"resourceExpirationTimeout"`
+	MaxSynchronousProcesses      int     `json:"maxSynchronousProcesses,omitempty"`
+	MaxAsynchronousProcesses     int     `json:"maxAsynchronousProcesses,omitempty"`
+	MaxSynchronousExecutionTime  int     `json:"maxSynchronousExecutionTime"`
+	MaxSynchronousTotalTime      int     `json:"maxSynchronousTotalTime"`
+	MaxAsynchronousExecutionTime int     `json:"maxAsynchronousExecutionTime"`
+	MaxAsynchronousTotalTime     int     `json:"maxAsynchronousTotalTime"`
+	StorageDirectory             string  `json:"storageDirectory"`
+	// The process groups are sent merged in the current ones, see mergeWpsProcessGroups, and only when some are managed
+	ProcessGroups *restWpsMergedProcessGroups `json:"processGroups,omitempty"`
+}
+
+// restWpsProcessGroupsBody reads the process groups of the WPS service
+type restWpsProcessGroupsBody struct {
+	ProcessGroups *restWpsProcessGroups `json:"processGroups"`
+}
+
+type restWpsProcessGroups struct {
+	ProcessGroups restList[restWpsProcessGroup] `json:"processGroup"`
+}
+
+// restWpsMergedProcessGroups holds the process groups of Geoserver as generic objects, for the fields
+// not managed by the resource, like the metadata, to be kept
+type restWpsMergedProcessGroups struct {
+	ProcessGroups []interface{} `json:"processGroup"`
+}
+
+func (g *restWpsMergedProcessGroups) UnmarshalJSON(data []byte) error {
+	// The groups are only sent this way, they are read by restWpsProcessGroupsBody
+	return nil
+}
+
+type restWpsProcessGroup struct {
+	FactoryClass      string               `json:"factoryClass"`
+	Enabled           bool                 `json:"enabled"`
+	Roles             *restStrings         `json:"roles"`
+	FilteredProcesses *restWpsProcessInfos `json:"filteredProcesses"`
+}
+
+type restWpsProcessInfos struct {
+	Processes restList[restWpsProcessInfo] `json:"accessInfo"`
+}
+
+type restWpsProcessInfo struct {
+	Name    string       `json:"name"`
+	Enabled bool         `json:"enabled"`
+	Roles   *restStrings `json:"roles"`
+}
+
+func (g *restWpsProcessGroups) UnmarshalJSON(data []byte) error {
+	// An empty list of groups is serialized as an empty string
+	var empty string
+	if json.Unmarshal(data, &empty) == nil {
+		*g = restWpsProcessGroups{}
+		return nil
+	}
+
+	type groups restWpsProcessGroups
+	return json.Unmarshal(data, (*groups)(g))
+}
+
+func (p *restWpsProcessInfos) UnmarshalJSON(data []byte) error {
+	// An empty list of processes is serialized as an empty string
+	var empty string
+	if json.Unmarshal(data, &empty) == nil {
+		*p = restWpsProcessInfos{}
+		return nil
+	}
+
+	type processes restWpsProcessInfos
+	return json.Unmarshal(data, (*processes)(p))
+}
+
+var wpsService = &ogcService{
+	name:        "wps",
+	label:       "WPS",
+	serviceName: "WPS",
+	expand: func(d *schema.ResourceData, service restService, current json.RawMessage) interface{} {
+		wps := &restWps{
+			restService:                  service,
+			ConnectionTimeout:            float64(d.Get("connection_timeout").(int)),
+			ResourceExpirationTimeout:    d.Get("resource_expiration_timeout").(int),
+			MaxSynchronousProcesses:      d.Get("max_synchronous_processes").(int),
+			MaxAsynchronousProcesses:     d.Get("max_asynchronous_processes").(int),
+			MaxSynchronousExecutionTime:  d.Get("max_synchronous_execution_time").(int),
+			MaxSynchronousTotalTime:      d.Get("max_synchronous_total_time").(int),
+			MaxAsynchronousExecutionTime: d.Get("max_asynchronous_execution_time").(int),
+			MaxAsynchronousTotalTime:     d.Get("max_asynchronous_total_time").(int),
+			StorageDirectory:             d.Get("storage_directory").(string),
+		}
+
+		wps.ProcessGroups = mergeWpsProcessGroups(d, current)
+
+		return wps
+	},
+	flatten: func(d *schema.ResourceData, raw json.RawMessage) error {
+		var wps restWps
+		err := json.Unmarshal(raw, &wps)
+		if err != nil {
+			return fmt.Errorf("unable to decode the WPS service configuration: %s", err)
+		}
+
+		var processGroups restWpsProcessGroupsBody
+		err = json.Unmarshal(raw, &processGroups)
+		if err != nil {
+			return fmt.Errorf("unable to decode the WPS process groups: %s", err)
+		}
+
+		d.Set("connection_timeout", int(wps.ConnectionTimeout))
+		d.Set("resource_expiration_timeout", wps.ResourceExpirationTimeout)
+		d.Set("max_synchronous_processes", wps.MaxSynchronousProcesses)
+		d.Set("max_asynchronous_processes", wps.MaxAsynchronousProcesses)
+		d.Set("max_synchronous_execution_time", wps.MaxSynchronousExecutionTime)
+		d.Set("max_synchronous_total_time", wps.MaxSynchronousTotalTime)
+		d.Set("max_asynchronous_execution_time", wps.MaxAsynchronousExecutionTime)
+		d.Set("max_asynchronous_total_time", wps.MaxAsynchronousTotalTime)
+		d.Set("storage_directory", wps.StorageDirectory)
+
+		// Geoserver lists all the process factories, only the configured ones are read back
+		configured := map[string]bool{}
+		for _, value := range d.Get("process_group").(*schema.Set).List() {
+			configured[value.(map[string]interface{})["factory_class"].(string)] = true
+		}
+
+		var groups []interface{}
+		if processGroups.ProcessGroups != nil {
+			for _, group := range processGroups.ProcessGroups.ProcessGroups {
+				if !configured[group.FactoryClass] {
+					continue
+				}
+
+				var processes []interface{}
+				if group.FilteredProcesses != nil {
+					for _, process := range group.FilteredProcesses.Processes {
+						processes = append(processes, map[string]interface{}{
+							"name":    process.Name,
+							"enabled": process.Enabled,
+							"roles":   flattenStrings(process.Roles),
+						})
+					}
+				}
+
+				groups = append(groups, map[string]interface{}{
+					"factory_class": group.FactoryClass,
+					"enabled":       group.Enabled,
+					"roles":         flattenStrings(group.Roles),
+					"process":       processes,
+				})
+			}
+		}
+		d.Set("process_group", groups)
+
+		return nil
+	},
+}
+
+func expandWpsProcessGroup(value interface{}) restWpsProcessGroup {
+	group := value.(map[string]interface{})
+
+	processes := &restWpsProcessInfos{Processes: restList[restWpsProcessInfo]{}}
+	for _, processValue := range group["process"].(*schema.Set).List() {
+		process := processValue.(map[string]interface{})
+		processes.Processes = append(processes.Processes, restWpsProcessInfo{
+			Name:    process["name"].(string),
+			Enabled: process["enabled"].(bool),
+			Roles:   expandStrings(process["roles"].([]interface{})),
+		})
+	}
+
+	return restWpsProcessGroup{
+		FactoryClass:      group["factory_class"].(string),
+		Enabled:           group["enabled"].(bool),
+		Roles:             expandStrings(group["roles"].([]interface{})),
+		FilteredProcesses: processes,
+	}
+}
+
+// mergeWpsProcessGroups merges the configured process groups in the current ones by factory class. The groups
+// removed from the configuration are reset to enabled, without role nor process restriction, and the groups never
+// configured are kept as they are. Nil is returned when no group is managed, the groups of Geoserver being kept.
+func mergeWpsProcessGroups(d *schema.ResourceData, current json.RawMessage) *restWpsMergedProcessGroups {
+	configured := map[string]restWpsProcessGroup{}
+	for _, value := range d.Get("process_group").(*schema.Set).List() {
+		group := expandWpsProcessGroup(value)
+		configured[group.FactoryClass] = group
+	}
+
+	removed := map[string]bool{}
+	previous, _ := d.GetChange("process_group")
+	for _, value := range previous.(*schema.Set).List() {
+		factoryClass := value.(map[string]interface{})["factory_class"].(string)
+		if _, ok := configured[factoryClass]; !ok {
+			removed[factoryClass] = true
+		}
+	}
+
+	if len(configured) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	// An empty list of groups is serialized as an empty string, which is not decoded
+	var body struct {
+		ProcessGroups struct {
+			ProcessGroups restList[map[string]interface{}] `json:"processGroup"`
+		} `json:"processGroups"`
+	}
+	if current != nil {
+		json.Unmarshal(current, &body)
+	}
+
+	merged := &restWpsMergedProcessGroups{ProcessGroups: []interface{}{}}
+	for _, group := range body.ProcessGroups.ProcessGroups {
+		factoryClass, _ := group["factoryClass"].(string)
+		if configuredGroup, ok := configured[factoryClass]; ok {
+			group["enabled"] = configuredGroup.Enabled
+			group["roles"] = configuredGroup.Roles
+			group["filteredProcesses"] = configuredGroup.FilteredProcesses
+			delete(configured, factoryClass)
+		} else if removed[factoryClass] {
+			group["enabled"] = true
+			group["roles"] = &restStrings{Strings: restList[string]{}}
+			group["filteredProcesses"] = &restWpsProcessInfos{Processes: restList[restWpsProcessInfo]{}}
+		}
+		merged.ProcessGroups = append(merged.ProcessGroups, group)
+	}
+
+	// The configured factories Geoserver does not list yet are added
+	for _, value := range d.Get("process_group").(*schema.Set).List() {
+		if group, ok := configured[value.(map[string]interface{})["factory_class"].(string)]; ok {
+			merged.ProcessGroups = append(merged.ProcessGroups, group)
+		}
+	}
+
+	return merged
+}
+
+func resourceGeoServerServiceWps() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage global or per-workspace WPS configuration. The configuration is a singleton so Create is similar to Update and Delete has no effect.",
+		Create:      wpsService.create,
+		Read:        wpsService.read,
+		Update:      wpsService.update,
+		Delete:      wpsService.delete,
+		Importer: &schema.ResourceImporter{
+			State: wpsService.importState,
+		},
+
+		Schema: serviceSchema("WPS", map[string]*schema.Schema{
+			"connection_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Timeout in seconds of the connections fetching remote inputs. Default value is 30.",
+			},
+			"resource_expiration_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     300,
+				Description: "Time in seconds the results of the asynchronous executions are kept. Default value is 300.",
+			},
+			"max_synchronous_processes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of synchronous executions running at the same time, the others being queued. The Geoserver value, the number of processors by default, is kept when not set.",
+			},
+			"max_asynchronous_processes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of asynchronous executions running at the same time, the others being queued. The Geoserver value, the number of processors by default, is kept when not set.",
+			},
+			"max_synchronous_execution_time": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum time in seconds a synchronous execution may run. Default value is 0 (no limit).",
+			},
+			"max_synchronous_total_time": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum time in seconds a synchronous execution may take, queuing included. Default value is 0 (no limit).",
+			},
+			"max_asynchronous_execution_time": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum time in seconds an asynchronous execution may run. Default value is 0 (no limit).",
+			},
+			"max_asynchronous_total_time": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum time in seconds an asynchronous execution may take, queuing included. Default value is 0 (no limit).",
+			},
+			"storage_directory": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Directory storing the results of the executions. The temporary directory of the system is used when empty.",
+			},
+			"process_group": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration of a process factory, e.g. org.geoserver.wps.jts.SpringBeanProcessFactory. Only the listed factories are managed, a factory removed from the list being enabled again without restriction.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"factory_class": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Class of the process factory.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Offer the processes of the factory. Default value is true.",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Roles allowed to run the processes of the factory. Everybody may run them when empty.",
+						},
+						"process": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Configuration of a process of the factory. The processes not listed follow the factory.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Qualified name of the process, e.g. JTS:buffer.",
+									},
+									"enabled": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     true,
+										Description: "Offer the process. Default value is true.",
+									},
+									"roles": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Roles allowed to run the process. Everybody may run it when empty.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
@@ -24,12 +24,13 @@ func resourceGeoserverStyle() *schema.Resource {
 			"workspace_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				ForceNew:    true,
 				Description: "Name of the workspace owning the style. Used to compute the id of the resource.",
 			},
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Name of the style. Used to compute the id of the resource.",
+				Description: "Name of the style. Used to compute the id of the resource. Geoserver does not rename styles: a renamed style is created under its new name, the layers and layer groups using it are switched to it, then the old style is deleted.",
 			},
 			"filename": {
 				Type:        schema.TypeString,
@@ -46,6 +47,8 @@ func resourceGeoserverStyle() *schema.Resource {
 				Optional:    true,
 				Description: "Version of the format. Only used for a SLD format.",
 			},
+			"adopt_existing": adoptExistingSchema(),
+			"force_destroy":  forceDestroySchema("Remove the style from the layers and layer groups using it when destroying it. The destroy fails and lists them otherwise. Default value is false."),
 			"style_definition": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -62,6 +65,26 @@ func resourceGeoserverStyleCreate(d *schema.ResourceData, meta interface{}) erro
 
 	workspaceName := d.Get("workspace_name").(string)
 
+	if adoptExisting(d, meta) {
+		existing, err := client.GetStyle(workspaceName, d.Get("name").(string))
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return err
+		}
+
+		if existing != nil {
+			d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
+
+			log.Printf("[INFO] Adopting existing Geoserver Style: %s", d.Id())
+
+			err = resourceGeoserverStyleUpdate(d, meta)
+			if err != nil {
+				return err
+			}
+
+			return resourceGeoserverStyleRead(d, meta)
+		}
+	}
+
 	style := &gs.Style{
 		Name:     d.Get("name").(string),
 		FileName: d.Get("filename").(string),
@@ -127,7 +150,18 @@ func resourceGeoserverStyleDelete(d *schema.ResourceData, meta interface{}) erro
 
 	client := meta.(*Config).GeoserverClient()
 
-	err := client.DeleteStyle(workspaceName, styleName, true, true)
+	forceDestroy := d.Get("force_destroy").(bool)
+	if !forceDestroy {
+		dependents, err := styleDependents(meta, workspaceName, styleName)
+		if err != nil {
+			return err
+		}
+		if len(dependents) > 0 {
+			return refuseDestroy("style", d.Id(), dependents)
+		}
+	}
+
+	err := client.DeleteStyle(workspaceName, styleName, true, forceDestroy)
 	if err != nil {
 		return err
 	}
@@ -142,6 +176,7 @@ func resourceGeoserverStyleUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	splittedID := strings.Split(d.Id(), "/")
 	workspaceName := splittedID[0]
+	styleName := splittedID[1]
 
 	client := meta.(*Config).GeoserverClient()
 
@@ -152,6 +187,10 @@ func resourceGeoserverStyleUpdate(d *schema.ResourceData, meta interface{}) erro
 		Version:  &gs.LanguageVersion{Version: d.Get("version").(string)},
 	}
 
+	if style.Name != styleName {
+		return renameStyle(d, meta, workspaceName, styleName, style)
+	}
+
 	errUpdateStyle := client.UpdateStyleContent(workspaceName, style, d.Get("style_definition").(string))
 	if errUpdateStyle != nil {
 		return errUpdateStyle
@@ -160,6 +199,51 @@ func resourceGeoserverStyleUpdate(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// renameStyle replaces a style by a new one, as Geoserver refuses to rename styles. The new style is created,
+// or updated when a previous attempt already created it, the layers and layer groups are switched to it,
+// then the old style is deleted. The id follows the new name once the old style is gone.
+func renameStyle(d *schema.ResourceData, meta interface{}, workspaceName string, styleName string, style *gs.Style) error {
+	log.Printf("[INFO] Renaming Geoserver Style %s to %s", d.Id(), style.Name)
+
+	client := meta.(*Config).GeoserverClient()
+
+	existing, err := client.GetStyle(workspaceName, style.Name)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+	if existing == nil {
+		err = client.CreateStyle(workspaceName, style)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.UpdateStyleContent(workspaceName, style, d.Get("style_definition").(string))
+	if err != nil {
+		return err
+	}
+
+	dependents, err := findStyleDependents(meta, workspaceName, styleName)
+	if err != nil {
+		return err
+	}
+	for _, dependent := range dependents {
+		err = repointStyle(meta, dependent, qualifiedStyleName(workspaceName, styleName), qualifiedStyleName(workspaceName, style.Name))
+		if err != nil {
+			return fmt.Errorf("unable to switch %s to style %s: %s", dependent.label, style.Name, err)
+		}
+	}
+
+	err = client.DeleteStyle(workspaceName, styleName, true, false)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspaceName, style.Name))
+
+	return nil
+}
+
 func resourceGeoserverStyleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	splittedID := strings.Split(d.Id(), "/")
 	workspaceName := splittedID[0]
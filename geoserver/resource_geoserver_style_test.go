@@ -0,0 +1,136 @@
+package geoserver
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestRenameStyleLinks(t *testing.T) {
+	layer := map[string]interface{}{
+		"defaultStyle": map[string]interface{}{"name": "ws:roads", "workspace": "ws", "href": "http://localhost/geoserver/rest/workspaces/ws/styles/roads.json"},
+		"styles": map[string]interface{}{
+			"style": []interface{}{
+				map[string]interface{}{"name": "ws:roads", "workspace": "ws"},
+				map[string]interface{}{"name": "ws:rivers", "workspace": "ws"},
+				"",
+			},
+		},
+	}
+
+	renamed := renameStyleLinks(layer, "ws:roads", "ws:streets").(map[string]interface{})
+
+	defaultStyle := renamed["defaultStyle"].(map[string]interface{})
+	if defaultStyle["name"] != "ws:streets" {
+		t.Errorf("expected the default style to be renamed, got %v", defaultStyle["name"])
+	}
+	if _, ok := defaultStyle["href"]; ok {
+		t.Error("expected the href of the old style to be dropped")
+	}
+
+	styles := renamed["styles"].(map[string]interface{})["style"].([]interface{})
+	if name := styles[0].(map[string]interface{})["name"]; name != "ws:streets" {
+		t.Errorf("expected the style to be renamed, got %v", name)
+	}
+	if name := styles[1].(map[string]interface{})["name"]; name != "ws:rivers" {
+		t.Errorf("expected the other style to be kept, got %v", name)
+	}
+	if styles[2] != "" {
+		t.Errorf("expected the default entry to be kept, got %v", styles[2])
+	}
+}
+
+func TestAccGeoserverStyle_rename(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGeoserverStyleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeoserverStyleConfig("tf_acc_style"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("geoserver_style.test", "id", "/tf_acc_style"),
+					resource.TestCheckResourceAttr("geoserver_layergroup.test", "layers.0.style", "tf_acc_style"),
+				),
+			},
+			{
+				// The layer group is switched to the renamed style before the old one is deleted
+				Config: testAccGeoserverStyleConfig("tf_acc_style_renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("geoserver_style.test", "id", "/tf_acc_style_renamed"),
+					resource.TestCheckResourceAttr("geoserver_layergroup.test", "layers.0.style", "tf_acc_style_renamed"),
+					testAccCheckGeoserverStyleMissing("", "tf_acc_style"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGeoserverStyleConfig(name string) string {
+	return fmt.Sprintf(`
+resource "geoserver_style" "test" {
+  name     = %[1]q
+  filename = "%[1]s.sld"
+  format   = "sld"
+  version  = "1.0.0"
+
+  style_definition = <<EOT
+<?xml version="1.0" encoding="UTF-8"?>
+<StyledLayerDescriptor version="1.0.0" xmlns="http://www.opengis.net/sld" xmlns:ogc="http://www.opengis.net/ogc">
+  <NamedLayer>
+    <Name>%[1]s</Name>
+    <UserStyle>
+      <FeatureTypeStyle>
+        <Rule>
+          <PolygonSymbolizer/>
+        </Rule>
+      </FeatureTypeStyle>
+    </UserStyle>
+  </NamedLayer>
+</StyledLayerDescriptor>
+EOT
+}
+
+resource "geoserver_layergroup" "test" {
+  name = "tf_acc_style_group"
+
+  layers {
+    name  = "topp:states"
+    style = geoserver_style.test.name
+  }
+}
+`, name)
+}
+
+func testAccCheckGeoserverStyleMissing(workspaceName string, styleName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Config).GeoserverClient()
+
+		style, err := client.GetStyle(workspaceName, styleName)
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return err
+		}
+		if style != nil {
+			return fmt.Errorf("style %s/%s still exists", workspaceName, styleName)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGeoserverStyleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "geoserver_style" {
+			continue
+		}
+
+		splittedID := strings.Split(rs.Primary.ID, "/")
+		err := testAccCheckGeoserverStyleMissing(splittedID[0], splittedID[1])(s)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package geoserver
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceGeoserverUserGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage a group of a user/group service. The members of the group are managed by geoserver_user_group_membership and its roles by geoserver_group_role.",
+		Create:      resourceGeoserverUserGroupCreate,
+		Read:        resourceGeoserverUserGroupRead,
+		Delete:      resourceGeoserverUserGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGeoserverUserGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the user/group service. If empty the default geoserver one will be used. Used to compute the id of the resource.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the group. Used to compute the id of the resource.",
+			},
+		},
+	}
+}
+
+func resourceGeoserverUserGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Creating Geoserver User Group: %s", d.Get("name").(string))
+
+	serviceName := d.Get("service_name").(string)
+	groupName := d.Get("name").(string)
+
+	client := meta.(*Config).RestClient()
+
+	_, err := client.Do(http.MethodPost, fmt.Sprintf("%s/group/%s", userGroupPath(serviceName), groupName), "", nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, groupName))
+
+	return resourceGeoserverUserGroupRead(d, meta)
+}
+
+func resourceGeoserverUserGroupRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Geoserver User Group: %s", d.Id())
+
+	splittedID := strings.Split(d.Id(), "/")
+	serviceName := splittedID[0]
+	groupName := splittedID[1]
+
+	client := meta.(*Config).RestClient()
+
+	var body restGroups
+	err := client.GetJSON(fmt.Sprintf("%s/groups", userGroupPath(serviceName)), &body)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+
+	if err != nil || !slices.Contains(body.Groups, groupName) {
+		d.SetId("")
+		return nil
+	}
+	d.Set("service_name", serviceName)
+	d.Set("name", groupName)
+
+	return nil
+}
+
+func resourceGeoserverUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Geoserver User Group: %s", d.Id())
+
+	splittedID := strings.Split(d.Id(), "/")
+	serviceName := splittedID[0]
+	groupName := splittedID[1]
+
+	client := meta.(*Config).RestClient()
+
+	err := client.Delete(fmt.Sprintf("%s/group/%s", userGroupPath(serviceName), groupName))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceGeoserverUserGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	splittedID := strings.Split(d.Id(), "/")
+	if len(splittedID) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("invalid id %q, expected <service>/<group> with an empty service for the default one", d.Id())
+	}
+	serviceName := splittedID[0]
+	groupName := splittedID[1]
+
+	d.SetId(d.Id())
+	d.Set("name", groupName)
+	d.Set("service_name", serviceName)
+
+	log.Printf("[INFO] Importing Geoserver User Group `%s` in service `%s`", groupName, serviceName)
+
+	err := resourceGeoserverUserGroupRead(d, meta)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,194 @@
+package geoserver
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceGeoserverUserGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage the members of a group of a user/group service. When authoritative, the users of the group not listed are removed from it, otherwise only the listed users are managed.",
+		Create:      resourceGeoserverUserGroupMembershipCreate,
+		Read:        resourceGeoserverUserGroupMembershipRead,
+		Update:      resourceGeoserverUserGroupMembershipUpdate,
+		Delete:      resourceGeoserverUserGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGeoserverUserGroupMembershipImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the user/group service. If empty the default geoserver one will be used. Used to compute the id of the resource.",
+			},
+			"group_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the group. Used to compute the id of the resource.",
+			},
+			"users": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the users members of the group.",
+			},
+			"authoritative": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Remove from the group the users not listed in users. Only the listed users are added and removed when false. Default value is true.",
+			},
+		},
+	}
+}
+
+func resourceGeoserverUserGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	serviceName := d.Get("service_name").(string)
+	groupName := d.Get("group_name").(string)
+
+	log.Printf("[INFO] Creating Geoserver User Group Membership: %s", groupName)
+
+	err := syncGroupMembers(d, meta, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, groupName))
+
+	return resourceGeoserverUserGroupMembershipRead(d, meta)
+}
+
+func resourceGeoserverUserGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Geoserver User Group Membership: %s", d.Id())
+
+	splittedID := strings.Split(d.Id(), "/")
+	serviceName := splittedID[0]
+	groupName := splittedID[1]
+
+	members, err := groupMembers(meta, serviceName, groupName)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+
+	if err != nil {
+		d.SetId("")
+		return nil
+	}
+	d.Set("service_name", serviceName)
+	d.Set("group_name", groupName)
+
+	// When additive, the members added outside of the resource are not reported as a drift
+	if !d.Get("authoritative").(bool) {
+		configured := d.Get("users").(*schema.Set)
+		members = slices.DeleteFunc(members, func(member string) bool {
+			return !configured.Contains(member)
+		})
+	}
+	d.Set("users", members)
+
+	return nil
+}
+
+func resourceGeoserverUserGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Geoserver User Group Membership: %s", d.Id())
+
+	previous, _ := d.GetChange("users")
+
+	return syncGroupMembers(d, meta, previous.(*schema.Set))
+}
+
+func resourceGeoserverUserGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Geoserver User Group Membership: %s", d.Id())
+
+	splittedID := strings.Split(d.Id(), "/")
+	serviceName := splittedID[0]
+	groupName := splittedID[1]
+
+	client := meta.(*Config).RestClient()
+
+	// Only the listed users are removed, whatever authoritative, the group itself being kept
+	for _, value := range d.Get("users").(*schema.Set).List() {
+		err := client.Delete(groupMembershipPath(serviceName, value.(string), groupName))
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceGeoserverUserGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	splittedID := strings.Split(d.Id(), "/")
+	if len(splittedID) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("invalid id %q, expected <service>/<group> with an empty service for the default one", d.Id())
+	}
+	serviceName := splittedID[0]
+	groupName := splittedID[1]
+
+	d.SetId(d.Id())
+	d.Set("service_name", serviceName)
+	d.Set("group_name", groupName)
+	d.Set("authoritative", true)
+
+	log.Printf("[INFO] Importing Geoserver User Group Membership `%s` in service `%s`", groupName, serviceName)
+
+	err := resourceGeoserverUserGroupMembershipRead(d, meta)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncGroupMembers adds the listed users missing from the group and removes the members no longer listed.
+// Those are the previously listed users, or all the other members when authoritative.
+func syncGroupMembers(d *schema.ResourceData, meta interface{}, previous *schema.Set) error {
+	serviceName := d.Get("service_name").(string)
+	groupName := d.Get("group_name").(string)
+
+	client := meta.(*Config).RestClient()
+
+	members, err := groupMembers(meta, serviceName, groupName)
+	if err != nil {
+		return err
+	}
+
+	users := d.Get("users").(*schema.Set)
+	for _, value := range users.List() {
+		userName := value.(string)
+		if slices.Contains(members, userName) {
+			continue
+		}
+
+		_, err := client.Do(http.MethodPost, groupMembershipPath(serviceName, userName, groupName), "", nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, member := range members {
+		if users.Contains(member) {
+			continue
+		}
+		if !d.Get("authoritative").(bool) && (previous == nil || !previous.Contains(member)) {
+			continue
+		}
+
+		err := client.Delete(groupMembershipPath(serviceName, member, groupName))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package geoserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var userRoleAssignment = &roleAssignment{
+	kind:  "user",
+	label: "User",
+}
+
+func resourceGeoserverUserRole() *schema.Resource {
+	return &schema.Resource{
+		Description: "Give a role to a user. Removing the resource takes the role back from the user.",
+		Create:      userRoleAssignment.create,
+		Read:        userRoleAssignment.read,
+		Delete:      userRoleAssignment.delete,
+		Importer: &schema.ResourceImporter{
+			State: userRoleAssignment.importState,
+		},
+
+		Schema: userRoleAssignment.schema(),
+	}
+}
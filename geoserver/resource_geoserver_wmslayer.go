@@ -11,14 +11,19 @@ import (
 )
 
 func resourceGeoserverWmsLayer() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Create: resourceGeoserverWmsLayerCreate,
 		Read:   resourceGeoserverWmsLayerRead,
-		//		Update: resourceGeoserverWmsLayerUpdate,
+		Update: resourceGeoserverWmsLayerUpdate,
 		Delete: resourceGeoserverWmsLayerDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceGeoserverWmsLayerImport,
 		},
+		CustomizeDiff: func(d *schema.ResourceDiff, meta interface{}) error {
+			// A rename of the WMS store is followed in place, a move to another one recreates the layer
+			return forceNewOnStoreMove(d, meta, "wmsstore_name", "wmsstores")
+		},
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"workspace_name": {
@@ -29,7 +34,6 @@ func resourceGeoserverWmsLayer() *schema.Resource {
 			"wmsstore_name": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -77,74 +81,26 @@ func resourceGeoserverWmsLayer() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"native_bounding_box_min_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"native_bounding_box_max_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"native_bounding_box_min_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"native_bounding_box_max_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"native_bounding_box_crs_class": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
-			"native_bounding_box_crs_value": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
-			"lat_lon_bounding_box_min_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"lat_lon_bounding_box_max_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"lat_lon_bounding_box_min_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"lat_lon_bounding_box_max_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"lat_lon_bounding_box_crs_class": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
-			"lat_lon_bounding_box_crs_value": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
+			"native_bounding_box":  boundingBoxSchema("Bounding box of the cascaded layer in its native CRS.", true),
+			"lat_lon_bounding_box": boundingBoxSchema("Bounding box of the cascaded layer in EPSG:4326.", true),
 			"metadata": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				ForceNew: true,
 			},
+			"recalculate":         recalculateSchema(),
+			"refresh_bounds_on":   refreshBoundsOnSchema(),
+			"time_dimension":      dimensionSchema("Time dimension of the cascaded layer. Stored in the time metadata entry.", true),
+			"elevation_dimension": dimensionSchema("Elevation dimension of the cascaded layer. Stored in the elevation metadata entry.", true),
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		boundingBoxStateUpgrader(resource, "native_bounding_box", "lat_lon_bounding_box"),
+	}
+
+	return resource
 }
 
 func resourceGeoserverWmsLayerCreate(d *schema.ResourceData, meta interface{}) error {
@@ -163,6 +119,12 @@ func resourceGeoserverWmsLayerCreate(d *schema.ResourceData, meta interface{}) e
 		})
 	}
 
+	// The dimensions are structured entries, sent with the whole metadata once the layer exists
+	restMetadata, err := expandMetadata(d)
+	if err != nil {
+		return err
+	}
+
 	WmsLayer := &gs.WmsLayer{
 		Name:             d.Get("name").(string),
 		NativeName:       d.Get("native_name").(string),
@@ -174,31 +136,13 @@ func resourceGeoserverWmsLayerCreate(d *schema.ResourceData, meta interface{}) e
 			Class: d.Get("native_crs_class").(string),
 			Value: d.Get("native_crs_value").(string),
 		},
-		SRS: d.Get("srs").(string),
-		NativeBoundingBox: gs.WmsLayerBoundingBox{
-			MinX: d.Get("native_bounding_box_min_x").(float64),
-			MaxX: d.Get("native_bounding_box_max_x").(float64),
-			MinY: d.Get("native_bounding_box_min_y").(float64),
-			MaxY: d.Get("native_bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("native_bounding_box_crs_class").(string),
-				Value: d.Get("native_bounding_box_crs_value").(string),
-			},
-		},
-		LatLonBoundingBox: gs.WmsLayerBoundingBox{
-			MinX: d.Get("lat_lon_bounding_box_min_x").(float64),
-			MaxX: d.Get("lat_lon_bounding_box_max_x").(float64),
-			MinY: d.Get("lat_lon_bounding_box_min_y").(float64),
-			MaxY: d.Get("lat_lon_bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("lat_lon_bounding_box_crs_class").(string),
-				Value: d.Get("lat_lon_bounding_box_crs_value").(string),
-			},
-		},
-		Metadata: metadata,
+		SRS:               d.Get("srs").(string),
+		NativeBoundingBox: wmsLayerBoundingBox(expandBoundingBox(d, "native_bounding_box")),
+		LatLonBoundingBox: wmsLayerBoundingBox(expandBoundingBox(d, "lat_lon_bounding_box")),
+		Metadata:          metadata,
 	}
 
-	err := client.CreateWmsLayer(workspaceName, datastoreName, WmsLayer)
+	err = client.CreateWmsLayer(workspaceName, datastoreName, WmsLayer)
 	if err != nil {
 		client.DeleteWmsLayer(workspaceName, datastoreName, d.Get("name").(string), true)
 		return err
@@ -206,6 +150,16 @@ func resourceGeoserverWmsLayerCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", workspaceName, datastoreName, d.Get("name").(string)))
 
+	err = updateMetadata(d, meta, wmsLayerPath(workspaceName, datastoreName, d.Get("name").(string)), "wmsLayer", restMetadata)
+	if err != nil {
+		return err
+	}
+
+	err = recalculateBounds(d, meta, wmsLayerPath(workspaceName, datastoreName, d.Get("name").(string)), "wmsLayer")
+	if err != nil {
+		return err
+	}
+
 	return resourceGeoserverWmsLayerRead(d, meta)
 }
 
@@ -239,27 +193,15 @@ func resourceGeoserverWmsLayerRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("native_crs_class", WmsLayer.NativeCRS.Class)
 	d.Set("native_crs_value", WmsLayer.NativeCRS.Value)
 
-	d.Set("native_bounding_box_min_x", WmsLayer.NativeBoundingBox.MinX)
-	d.Set("native_bounding_box_max_x", WmsLayer.NativeBoundingBox.MaxX)
-	d.Set("native_bounding_box_min_y", WmsLayer.NativeBoundingBox.MinY)
-	d.Set("native_bounding_box_max_y", WmsLayer.NativeBoundingBox.MaxY)
-	d.Set("native_bounding_box_crs_class", WmsLayer.NativeBoundingBox.CRS.Class)
-	d.Set("native_bounding_box_crs_value", WmsLayer.NativeBoundingBox.CRS.Value)
-
-	d.Set("lat_lon_bounding_box_min_x", WmsLayer.LatLonBoundingBox.MinX)
-	d.Set("lat_lon_bounding_box_max_x", WmsLayer.LatLonBoundingBox.MaxX)
-	d.Set("lat_lon_bounding_box_min_y", WmsLayer.LatLonBoundingBox.MinY)
-	d.Set("lat_lon_bounding_box_max_y", WmsLayer.LatLonBoundingBox.MaxY)
-	d.Set("lat_lon_bounding_box_crs_class", WmsLayer.LatLonBoundingBox.CRS.Class)
-	d.Set("lat_lon_bounding_box_crs_value", WmsLayer.LatLonBoundingBox.CRS.Value)
-
-	metadata := map[string]interface{}{}
-	if WmsLayer.Metadata != nil {
-		for _, entry := range WmsLayer.Metadata {
-			metadata[entry.Key] = entry.Value
-		}
-		d.Set("metadata", metadata)
+	d.Set("native_bounding_box", flattenWmsLayerBoundingBox(WmsLayer.NativeBoundingBox))
+
+	d.Set("lat_lon_bounding_box", flattenWmsLayerBoundingBox(WmsLayer.LatLonBoundingBox))
+
+	metadata, err := readMetadata(meta, wmsLayerPath(workspaceName, datastoreName, WmsLayerName), "wmsLayer")
+	if err != nil {
+		return err
 	}
+	flattenMetadata(d, metadata)
 
 	return nil
 }
@@ -289,7 +231,7 @@ func resourceGeoserverWmsLayerUpdate(d *schema.ResourceData, meta interface{}) e
 
 	splittedID := strings.Split(d.Id(), "/")
 	workspaceName := splittedID[0]
-	datastoreName := splittedID[1]
+	datastoreName := updatedStoreName(d, "wmsstore_name", splittedID[1])
 	WmsLayerName := splittedID[2]
 
 	client := meta.(*Config).GeoserverClient()
@@ -302,6 +244,12 @@ func resourceGeoserverWmsLayerUpdate(d *schema.ResourceData, meta interface{}) e
 		})
 	}
 
+	// The dimensions are structured entries, sent with the whole metadata once the layer exists
+	restMetadata, err := expandMetadata(d)
+	if err != nil {
+		return err
+	}
+
 	WmsLayer := &gs.WmsLayer{
 		Name:             d.Get("name").(string),
 		NativeName:       d.Get("native_name").(string),
@@ -313,31 +261,33 @@ func resourceGeoserverWmsLayerUpdate(d *schema.ResourceData, meta interface{}) e
 			Class: d.Get("native_crs_class").(string),
 			Value: d.Get("native_crs_value").(string),
 		},
-		SRS: d.Get("srs").(string),
-		NativeBoundingBox: gs.WmsLayerBoundingBox{
-			MinX: d.Get("native_bounding_box_min_x").(float64),
-			MaxX: d.Get("native_bounding_box_max_x").(float64),
-			MinY: d.Get("native_bounding_box_min_y").(float64),
-			MaxY: d.Get("native_bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("native_bounding_box_crs_class").(string),
-				Value: d.Get("native_bounding_box_crs_value").(string),
-			},
-		},
-		LatLonBoundingBox: gs.WmsLayerBoundingBox{
-			MinX: d.Get("lat_lon_bounding_box_min_x").(float64),
-			MaxX: d.Get("lat_lon_bounding_box_max_x").(float64),
-			MinY: d.Get("lat_lon_bounding_box_min_y").(float64),
-			MaxY: d.Get("lat_lon_bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("lat_lon_bounding_box_crs_class").(string),
-				Value: d.Get("lat_lon_bounding_box_crs_value").(string),
-			},
-		},
-		Metadata: metadata,
+		SRS:               d.Get("srs").(string),
+		NativeBoundingBox: wmsLayerBoundingBox(expandBoundingBox(d, "native_bounding_box")),
+		LatLonBoundingBox: wmsLayerBoundingBox(expandBoundingBox(d, "lat_lon_bounding_box")),
+		Metadata:          metadata,
+	}
+
+	err = client.UpdateWmsLayer(workspaceName, datastoreName, WmsLayerName, WmsLayer)
+	if err != nil {
+		if d.HasChange("wmsstore_name") {
+			return storeMoveError("WMS layer", d.Id(), datastoreName, err)
+		}
+		return err
+	}
+
+	// The id follows the renames of the store
+	id, err := rewriteStoreLayerID(d.Id(), datastoreName, WmsLayerName)
+	if err != nil {
+		return err
 	}
+	d.SetId(id)
 
-	err := client.UpdateWmsLayer(workspaceName, datastoreName, WmsLayerName, WmsLayer)
+	err = updateMetadata(d, meta, wmsLayerPath(workspaceName, datastoreName, WmsLayerName), "wmsLayer", restMetadata)
+	if err != nil {
+		return err
+	}
+
+	err = recalculateBounds(d, meta, wmsLayerPath(workspaceName, datastoreName, WmsLayerName), "wmsLayer")
 	if err != nil {
 		return err
 	}
@@ -365,3 +315,30 @@ func resourceGeoserverWmsLayerImport(d *schema.ResourceData, meta interface{}) (
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func wmsLayerPath(workspaceName string, storeName string, layerName string) string {
+	if storeName == "" {
+		return fmt.Sprintf("/workspaces/%s/wmslayers/%s", workspaceName, layerName)
+	}
+	return fmt.Sprintf("/workspaces/%s/wmsstores/%s/wmslayers/%s", workspaceName, storeName, layerName)
+}
+
+func wmsLayerBoundingBox(box gs.BoundingBox) gs.WmsLayerBoundingBox {
+	return gs.WmsLayerBoundingBox{
+		MinX: box.MinX,
+		MaxX: box.MaxX,
+		MinY: box.MinY,
+		MaxY: box.MaxY,
+		CRS:  box.CRS,
+	}
+}
+
+func flattenWmsLayerBoundingBox(box gs.WmsLayerBoundingBox) []map[string]interface{} {
+	return flattenBoundingBox(gs.BoundingBox{
+		MinX: box.MinX,
+		MaxX: box.MaxX,
+		MinY: box.MinY,
+		MaxY: box.MaxY,
+		CRS:  box.CRS,
+	})
+}
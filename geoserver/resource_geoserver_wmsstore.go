@@ -73,6 +73,9 @@ func resourceGeoserverWmsStore() *schema.Resource {
 				Default:     60,
 				Description: "Number of seconds before considering a read request in timeout. Default value is 60.",
 			},
+			"adopt_existing":      adoptExistingSchema(),
+			"force_destroy":       forceDestroySchema("Delete the layers published from the WMS store when destroying it. The destroy fails and lists them otherwise. Default value is false."),
+			"validate_connection": validateConnectionSchema(),
 			"connection_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -89,6 +92,27 @@ func resourceGeoserverWmsStoreCreate(d *schema.ResourceData, meta interface{}) e
 	client := meta.(*Config).GeoserverClient()
 
 	workspaceName := d.Get("workspace_name").(string)
+
+	if adoptExisting(d, meta) {
+		existing, err := client.GetWmsStore(workspaceName, d.Get("name").(string))
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return err
+		}
+
+		if existing != nil {
+			d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
+
+			log.Printf("[INFO] Adopting existing Geoserver WMS store: %s", d.Id())
+
+			err = resourceGeoserverWmsStoreUpdate(d, meta)
+			if err != nil {
+				return err
+			}
+
+			return resourceGeoserverWmsStoreRead(d, meta)
+		}
+	}
+
 	datastore := gs.NewWmsStore()
 	datastore.Name = d.Get("name").(string)
 	datastore.Description = d.Get("description").(string)
@@ -107,6 +131,11 @@ func resourceGeoserverWmsStoreCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
 
+	err = validateStoreConnection(d, meta, fmt.Sprintf("/workspaces/%s/wmsstores/%s/wmslayers", workspaceName, d.Get("name").(string)))
+	if err != nil {
+		return err
+	}
+
 	return resourceGeoserverWmsStoreRead(d, meta)
 }
 
@@ -151,7 +180,18 @@ func resourceGeoserverWmsStoreDelete(d *schema.ResourceData, meta interface{}) e
 
 	client := meta.(*Config).GeoserverClient()
 
-	err := client.DeleteWmsStore(workspaceName, datastoreName, true)
+	forceDestroy := d.Get("force_destroy").(bool)
+	if !forceDestroy {
+		dependents, err := storeDependents(meta, fmt.Sprintf("/workspaces/%s/wmsstores/%s/wmslayers", workspaceName, datastoreName), "wmsLayers", "wmsLayer")
+		if err != nil {
+			return err
+		}
+		if len(dependents) > 0 {
+			return refuseDestroy("WMS store", d.Id(), dependents)
+		}
+	}
+
+	err := client.DeleteWmsStore(workspaceName, datastoreName, forceDestroy)
 	if err != nil {
 		return err
 	}
@@ -186,6 +226,14 @@ func resourceGeoserverWmsStoreUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	// The update is issued against the old name, the id follows the new one
+	d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
+
+	err = validateStoreConnection(d, meta, fmt.Sprintf("/workspaces/%s/wmsstores/%s/wmslayers", workspaceName, d.Get("name").(string)))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -11,14 +11,19 @@ import (
 )
 
 func resourceGeoserverWmtsLayer() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Create: resourceGeoserverWmtsLayerCreate,
 		Read:   resourceGeoserverWmtsLayerRead,
-		//		Update: resourceGeoserverWmsLayerUpdate,
+		Update: resourceGeoserverWmtsLayerUpdate,
 		Delete: resourceGeoserverWmtsLayerDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceGeoserverWmtsLayerImport,
 		},
+		CustomizeDiff: func(d *schema.ResourceDiff, meta interface{}) error {
+			// A rename of the WMTS store is followed in place, a move to another one recreates the layer
+			return forceNewOnStoreMove(d, meta, "wmts_store_name", "wmtsstores")
+		},
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"workspace_name": {
@@ -29,7 +34,6 @@ func resourceGeoserverWmtsLayer() *schema.Resource {
 			"wmts_store_name": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -77,74 +81,26 @@ func resourceGeoserverWmtsLayer() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"native_bounding_box_min_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"native_bounding_box_max_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"native_bounding_box_min_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"native_bounding_box_max_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"native_bounding_box_crs_class": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
-			"native_bounding_box_crs_value": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
-			"lat_lon_bounding_box_min_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"lat_lon_bounding_box_max_x": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"lat_lon_bounding_box_min_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"lat_lon_bounding_box_max_y": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
-			},
-			"lat_lon_bounding_box_crs_class": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
-			"lat_lon_bounding_box_crs_value": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
+			"native_bounding_box":  boundingBoxSchema("Bounding box of the cascaded layer in its native CRS.", true),
+			"lat_lon_bounding_box": boundingBoxSchema("Bounding box of the cascaded layer in EPSG:4326.", true),
 			"metadata": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				ForceNew: true,
 			},
+			"recalculate":         recalculateSchema(),
+			"refresh_bounds_on":   refreshBoundsOnSchema(),
+			"time_dimension":      dimensionSchema("Time dimension of the cascaded layer. Stored in the time metadata entry.", true),
+			"elevation_dimension": dimensionSchema("Elevation dimension of the cascaded layer. Stored in the elevation metadata entry.", true),
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		boundingBoxStateUpgrader(resource, "native_bounding_box", "lat_lon_bounding_box"),
+	}
+
+	return resource
 }
 
 func resourceGeoserverWmtsLayerCreate(d *schema.ResourceData, meta interface{}) error {
@@ -163,6 +119,12 @@ func resourceGeoserverWmtsLayerCreate(d *schema.ResourceData, meta interface{})
 		})
 	}
 
+	// The dimensions are structured entries, sent with the whole metadata once the layer exists
+	restMetadata, err := expandMetadata(d)
+	if err != nil {
+		return err
+	}
+
 	WmtsLayer := &gs.WmtsLayer{
 		Name:             d.Get("name").(string),
 		NativeName:       d.Get("native_name").(string),
@@ -174,31 +136,13 @@ func resourceGeoserverWmtsLayerCreate(d *schema.ResourceData, meta interface{})
 			Class: d.Get("native_crs_class").(string),
 			Value: d.Get("native_crs_value").(string),
 		},
-		SRS: d.Get("srs").(string),
-		NativeBoundingBox: gs.WmtsLayerBoundingBox{
-			MinX: d.Get("native_bounding_box_min_x").(float64),
-			MaxX: d.Get("native_bounding_box_max_x").(float64),
-			MinY: d.Get("native_bounding_box_min_y").(float64),
-			MaxY: d.Get("native_bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("native_bounding_box_crs_class").(string),
-				Value: d.Get("native_bounding_box_crs_value").(string),
-			},
-		},
-		LatLonBoundingBox: gs.WmtsLayerBoundingBox{
-			MinX: d.Get("lat_lon_bounding_box_min_x").(float64),
-			MaxX: d.Get("lat_lon_bounding_box_max_x").(float64),
-			MinY: d.Get("lat_lon_bounding_box_min_y").(float64),
-			MaxY: d.Get("lat_lon_bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("lat_lon_bounding_box_crs_class").(string),
-				Value: d.Get("lat_lon_bounding_box_crs_value").(string),
-			},
-		},
-		Metadata: metadata,
+		SRS:               d.Get("srs").(string),
+		NativeBoundingBox: wmtsLayerBoundingBox(expandBoundingBox(d, "native_bounding_box")),
+		LatLonBoundingBox: wmtsLayerBoundingBox(expandBoundingBox(d, "lat_lon_bounding_box")),
+		Metadata:          metadata,
 	}
 
-	err := client.CreateWmtsLayer(workspaceName, datastoreName, WmtsLayer)
+	err = client.CreateWmtsLayer(workspaceName, datastoreName, WmtsLayer)
 	if err != nil {
 		client.DeleteWmtsLayer(workspaceName, datastoreName, d.Get("name").(string), true)
 		return err
@@ -206,6 +150,16 @@ func resourceGeoserverWmtsLayerCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", workspaceName, datastoreName, d.Get("name").(string)))
 
+	err = updateMetadata(d, meta, wmtsLayerPath(workspaceName, datastoreName, d.Get("name").(string)), "wmtsLayer", restMetadata)
+	if err != nil {
+		return err
+	}
+
+	err = recalculateBounds(d, meta, wmtsLayerPath(workspaceName, datastoreName, d.Get("name").(string)), "wmtsLayer")
+	if err != nil {
+		return err
+	}
+
 	return resourceGeoserverWmtsLayerRead(d, meta)
 }
 
@@ -239,27 +193,15 @@ func resourceGeoserverWmtsLayerRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("native_crs_class", WmtsLayer.NativeCRS.Class)
 	d.Set("native_crs_value", WmtsLayer.NativeCRS.Value)
 
-	d.Set("native_bounding_box_min_x", WmtsLayer.NativeBoundingBox.MinX)
-	d.Set("native_bounding_box_max_x", WmtsLayer.NativeBoundingBox.MaxX)
-	d.Set("native_bounding_box_min_y", WmtsLayer.NativeBoundingBox.MinY)
-	d.Set("native_bounding_box_max_y", WmtsLayer.NativeBoundingBox.MaxY)
-	d.Set("native_bounding_box_crs_class", WmtsLayer.NativeBoundingBox.CRS.Class)
-	d.Set("native_bounding_box_crs_value", WmtsLayer.NativeBoundingBox.CRS.Value)
-
-	d.Set("lat_lon_bounding_box_min_x", WmtsLayer.LatLonBoundingBox.MinX)
-	d.Set("lat_lon_bounding_box_max_x", WmtsLayer.LatLonBoundingBox.MaxX)
-	d.Set("lat_lon_bounding_box_min_y", WmtsLayer.LatLonBoundingBox.MinY)
-	d.Set("lat_lon_bounding_box_max_y", WmtsLayer.LatLonBoundingBox.MaxY)
-	d.Set("lat_lon_bounding_box_crs_class", WmtsLayer.LatLonBoundingBox.CRS.Class)
-	d.Set("lat_lon_bounding_box_crs_value", WmtsLayer.LatLonBoundingBox.CRS.Value)
-
-	metadata := map[string]interface{}{}
-	if WmtsLayer.Metadata != nil {
-		for _, entry := range WmtsLayer.Metadata {
-			metadata[entry.Key] = entry.Value
-		}
-		d.Set("metadata", metadata)
+	d.Set("native_bounding_box", flattenWmtsLayerBoundingBox(WmtsLayer.NativeBoundingBox))
+
+	d.Set("lat_lon_bounding_box", flattenWmtsLayerBoundingBox(WmtsLayer.LatLonBoundingBox))
+
+	metadata, err := readMetadata(meta, wmtsLayerPath(workspaceName, datastoreName, WmtsLayerName), "wmtsLayer")
+	if err != nil {
+		return err
 	}
+	flattenMetadata(d, metadata)
 
 	return nil
 }
@@ -289,7 +231,7 @@ func resourceGeoserverWmtsLayerUpdate(d *schema.ResourceData, meta interface{})
 
 	splittedID := strings.Split(d.Id(), "/")
 	workspaceName := splittedID[0]
-	datastoreName := splittedID[1]
+	datastoreName := updatedStoreName(d, "wmts_store_name", splittedID[1])
 	WmtsLayerName := splittedID[2]
 
 	client := meta.(*Config).GeoserverClient()
@@ -302,6 +244,12 @@ func resourceGeoserverWmtsLayerUpdate(d *schema.ResourceData, meta interface{})
 		})
 	}
 
+	// The dimensions are structured entries, sent with the whole metadata once the layer exists
+	restMetadata, err := expandMetadata(d)
+	if err != nil {
+		return err
+	}
+
 	WmtsLayer := &gs.WmtsLayer{
 		Name:             d.Get("name").(string),
 		NativeName:       d.Get("native_name").(string),
@@ -313,31 +261,33 @@ func resourceGeoserverWmtsLayerUpdate(d *schema.ResourceData, meta interface{})
 			Class: d.Get("native_crs_class").(string),
 			Value: d.Get("native_crs_value").(string),
 		},
-		SRS: d.Get("srs").(string),
-		NativeBoundingBox: gs.WmtsLayerBoundingBox{
-			MinX: d.Get("native_bounding_box_min_x").(float64),
-			MaxX: d.Get("native_bounding_box_max_x").(float64),
-			MinY: d.Get("native_bounding_box_min_y").(float64),
-			MaxY: d.Get("native_bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("native_bounding_box_crs_class").(string),
-				Value: d.Get("native_bounding_box_crs_value").(string),
-			},
-		},
-		LatLonBoundingBox: gs.WmtsLayerBoundingBox{
-			MinX: d.Get("lat_lon_bounding_box_min_x").(float64),
-			MaxX: d.Get("lat_lon_bounding_box_max_x").(float64),
-			MinY: d.Get("lat_lon_bounding_box_min_y").(float64),
-			MaxY: d.Get("lat_lon_bounding_box_max_y").(float64),
-			CRS: gs.FeatureTypeCRS{
-				Class: d.Get("lat_lon_bounding_box_crs_class").(string),
-				Value: d.Get("lat_lon_bounding_box_crs_value").(string),
-			},
-		},
-		Metadata: metadata,
+		SRS:               d.Get("srs").(string),
+		NativeBoundingBox: wmtsLayerBoundingBox(expandBoundingBox(d, "native_bounding_box")),
+		LatLonBoundingBox: wmtsLayerBoundingBox(expandBoundingBox(d, "lat_lon_bounding_box")),
+		Metadata:          metadata,
+	}
+
+	err = client.UpdateWmtsLayer(workspaceName, datastoreName, WmtsLayerName, WmtsLayer)
+	if err != nil {
+		if d.HasChange("wmts_store_name") {
+			return storeMoveError("WMTS layer", d.Id(), datastoreName, err)
+		}
+		return err
+	}
+
+	// The id follows the renames of the store
+	id, err := rewriteStoreLayerID(d.Id(), datastoreName, WmtsLayerName)
+	if err != nil {
+		return err
 	}
+	d.SetId(id)
 
-	err := client.UpdateWmtsLayer(workspaceName, datastoreName, WmtsLayerName, WmtsLayer)
+	err = updateMetadata(d, meta, wmtsLayerPath(workspaceName, datastoreName, WmtsLayerName), "wmtsLayer", restMetadata)
+	if err != nil {
+		return err
+	}
+
+	err = recalculateBounds(d, meta, wmtsLayerPath(workspaceName, datastoreName, WmtsLayerName), "wmtsLayer")
 	if err != nil {
 		return err
 	}
@@ -365,3 +315,30 @@ func resourceGeoserverWmtsLayerImport(d *schema.ResourceData, meta interface{})
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func wmtsLayerPath(workspaceName string, storeName string, layerName string) string {
+	if storeName == "" {
+		return fmt.Sprintf("/workspaces/%s/wmtslayers/%s", workspaceName, layerName)
+	}
+	return fmt.Sprintf("/workspaces/%s/wmtsstores/%s/layers/%s", workspaceName, storeName, layerName)
+}
+
+func wmtsLayerBoundingBox(box gs.BoundingBox) gs.WmtsLayerBoundingBox {
+	return gs.WmtsLayerBoundingBox{
+		MinX: box.MinX,
+		MaxX: box.MaxX,
+		MinY: box.MinY,
+		MaxY: box.MaxY,
+		CRS:  box.CRS,
+	}
+}
+
+func flattenWmtsLayerBoundingBox(box gs.WmtsLayerBoundingBox) []map[string]interface{} {
+	return flattenBoundingBox(gs.BoundingBox{
+		MinX: box.MinX,
+		MaxX: box.MaxX,
+		MinY: box.MinY,
+		MaxY: box.MaxY,
+		CRS:  box.CRS,
+	})
+}
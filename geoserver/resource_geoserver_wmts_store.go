@@ -56,6 +56,8 @@ func resourceGeoserverWmtsStore() *schema.Resource {
 				Default:     false,
 				Description: "Don't try to connect to remote server if failure occurs. Default value is false.",
 			},
+			"adopt_existing": adoptExistingSchema(),
+			"force_destroy":  forceDestroySchema("Delete the layers published from the WMTS store when destroying it. The destroy fails and lists them otherwise. Default value is false."),
 			"capabilities_url": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -89,6 +91,27 @@ func resourceGeoserverWmtsStoreCreate(d *schema.ResourceData, meta interface{})
 	client := meta.(*Config).GeoserverClient()
 
 	workspaceName := d.Get("workspace_name").(string)
+
+	if adoptExisting(d, meta) {
+		existing, err := client.GetWmtsStore(workspaceName, d.Get("name").(string))
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return err
+		}
+
+		if existing != nil {
+			d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
+
+			log.Printf("[INFO] Adopting existing Geoserver WMTS store: %s", d.Id())
+
+			err = resourceGeoserverWmtsStoreUpdate(d, meta)
+			if err != nil {
+				return err
+			}
+
+			return resourceGeoserverWmtsStoreRead(d, meta)
+		}
+	}
+
 	datastore := gs.NewWmtsStore()
 	datastore.Name = d.Get("name").(string)
 	datastore.Description = d.Get("description").(string)
@@ -151,7 +174,18 @@ func resourceGeoserverWmtsStoreDelete(d *schema.ResourceData, meta interface{})
 
 	client := meta.(*Config).GeoserverClient()
 
-	err := client.DeleteWmtsStore(workspaceName, datastoreName, true)
+	forceDestroy := d.Get("force_destroy").(bool)
+	if !forceDestroy {
+		dependents, err := storeDependents(meta, fmt.Sprintf("/workspaces/%s/wmtsstores/%s/layers", workspaceName, datastoreName), "wmtsLayers", "wmtsLayer")
+		if err != nil {
+			return err
+		}
+		if len(dependents) > 0 {
+			return refuseDestroy("WMTS store", d.Id(), dependents)
+		}
+	}
+
+	err := client.DeleteWmtsStore(workspaceName, datastoreName, forceDestroy)
 	if err != nil {
 		return err
 	}
@@ -186,6 +220,9 @@ func resourceGeoserverWmtsStoreUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	// The update is issued against the old name, the id follows the new one
+	d.SetId(fmt.Sprintf("%s/%s", workspaceName, d.Get("name").(string)))
+
 	return nil
 }
 
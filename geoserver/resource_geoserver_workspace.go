@@ -1,7 +1,9 @@
 package geoserver
 
 import (
+	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -23,15 +25,17 @@ func resourceGeoserverWorkspace() *schema.Resource {
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
-				Description: "Name of the workspace. Use as resource id.",
+				Description: "Name of the workspace. Use as resource id. Renaming the workspace also renames its namespace prefix. The resources of the workspace are not renamed with it: as their workspace_name forces a new resource, the stores, layers, styles, layer groups, granules and settings referencing the workspace are destroyed before the rename and recreated in the renamed workspace.",
 			},
 			"default": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				ForceNew:    true,
-				Description: "Declare the workspace as default workspace. Default value: false.",
+				Description: "Declare the workspace as default workspace. Geoserver always has a default workspace, so setting it back to false keeps the workspace as default until another one is declared. Default value: false.",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// The default workspace can only be replaced, not unset
+					return new == "false"
+				},
 			},
 			"isolated": {
 				Type:        schema.TypeBool,
@@ -39,6 +43,14 @@ func resourceGeoserverWorkspace() *schema.Resource {
 				Default:     false,
 				Description: "Declare the workspace as isolated workspace. Default value: false.",
 			},
+			"adopt_existing": adoptExistingSchema(),
+			"force_destroy":  forceDestroySchema("Delete the stores, layers, styles and layer groups of the workspace when destroying it. The destroy fails and lists them otherwise. Default value is false."),
+			"namespace_uri": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "URI of the namespace associated to the workspace. Geoserver generates one when empty.",
+			},
 		},
 	}
 }
@@ -50,6 +62,26 @@ func resourceGeoserverWorkspaceCreate(d *schema.ResourceData, meta interface{})
 
 	name := d.Get("name").(string)
 
+	if adoptExisting(d, meta) {
+		existing, err := client.GetWorkspace(name)
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return err
+		}
+
+		if existing != nil {
+			d.SetId(name)
+
+			log.Printf("[INFO] Adopting existing Geoserver Workspace: %s", d.Id())
+
+			err = resourceGeoserverWorkspaceUpdate(d, meta)
+			if err != nil {
+				return err
+			}
+
+			return resourceGeoserverWorkspaceRead(d, meta)
+		}
+	}
+
 	err := client.CreateWorkspace(&gs.Workspace{
 		Name:     name,
 		Isolated: d.Get("isolated").(bool),
@@ -60,6 +92,13 @@ func resourceGeoserverWorkspaceCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(name)
 
+	if d.Get("namespace_uri").(string) != "" {
+		err = updateWorkspaceNamespace(d, meta)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceGeoserverWorkspaceRead(d, meta)
 }
 
@@ -81,6 +120,22 @@ func resourceGeoserverWorkspaceRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("name", workspace.Name)
 	d.Set("isolated", workspace.Isolated)
 
+	restClient := meta.(*Config).RestClient()
+
+	var namespace workspaceNamespace
+	err = restClient.GetJSON(fmt.Sprintf("/namespaces/%s.json", d.Id()), &namespace)
+	if err != nil {
+		return err
+	}
+	d.Set("namespace_uri", namespace.Namespace.URI)
+
+	var defaultWorkspace workspaceDefault
+	err = restClient.GetJSON("/workspaces/default.json", &defaultWorkspace)
+	if err != nil {
+		return err
+	}
+	d.Set("default", defaultWorkspace.Workspace.Name == workspace.Name)
+
 	return nil
 }
 
@@ -89,7 +144,18 @@ func resourceGeoserverWorkspaceDelete(d *schema.ResourceData, meta interface{})
 
 	client := meta.(*Config).GeoserverClient()
 
-	err := client.DeleteWorkspace(d.Id(), true)
+	forceDestroy := d.Get("force_destroy").(bool)
+	if !forceDestroy {
+		dependents, err := workspaceDependents(meta, d.Id())
+		if err != nil {
+			return err
+		}
+		if len(dependents) > 0 {
+			return refuseDestroy("workspace", d.Id(), dependents)
+		}
+	}
+
+	err := client.DeleteWorkspace(d.Id(), forceDestroy)
 	if err != nil {
 		return err
 	}
@@ -112,9 +178,53 @@ func resourceGeoserverWorkspaceUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	// The update is issued against the old name, the id follows the new one
+	d.SetId(d.Get("name").(string))
+
+	if d.HasChange("namespace_uri") && d.Get("namespace_uri").(string) != "" {
+		err = updateWorkspaceNamespace(d, meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("default") && d.Get("default").(bool) {
+		restClient := meta.(*Config).RestClient()
+
+		var defaultWorkspace workspaceDefault
+		defaultWorkspace.Workspace.Name = d.Id()
+		err = restClient.SendJSON(http.MethodPut, "/workspaces/default", &defaultWorkspace)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+type workspaceNamespace struct {
+	Namespace struct {
+		Prefix string `json:"prefix"`
+		URI    string `json:"uri"`
+	} `json:"namespace"`
+}
+
+type workspaceDefault struct {
+	Workspace struct {
+		Name string `json:"name"`
+	} `json:"workspace"`
+}
+
+func updateWorkspaceNamespace(d *schema.ResourceData, meta interface{}) error {
+	restClient := meta.(*Config).RestClient()
+
+	var namespace workspaceNamespace
+	namespace.Namespace.Prefix = d.Id()
+	namespace.Namespace.URI = d.Get("namespace_uri").(string)
+
+	return restClient.SendJSON(http.MethodPut, fmt.Sprintf("/namespaces/%s", d.Id()), &namespace)
+}
+
 func resourceGeoserverWorkspaceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.SetId(d.Id())
 	d.Set("name", d.Id())
@@ -0,0 +1,121 @@
+package geoserver
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type workspaceSettingsBody struct {
+	Settings restSettings `json:"settings"`
+}
+
+func resourceGeoserverWorkspaceSettings() *schema.Resource {
+	attributes := settingsAttributes()
+	attributes["workspace_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the workspace owning the settings. Used as the id of the resource.",
+	}
+
+	return &schema.Resource{
+		Description: "Manage the settings local to a workspace. They override the global settings for the virtual services of the workspace.",
+		Create:      resourceGeoserverWorkspaceSettingsCreate,
+		Read:        resourceGeoserverWorkspaceSettingsRead,
+		Update:      resourceGeoserverWorkspaceSettingsUpdate,
+		Delete:      resourceGeoserverWorkspaceSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGeoserverWorkspaceSettingsImport,
+		},
+
+		Schema: attributes,
+	}
+}
+
+func resourceGeoserverWorkspaceSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Creating Geoserver Workspace Settings: %s", d.Get("workspace_name").(string))
+
+	client := meta.(*Config).RestClient()
+
+	workspaceName := d.Get("workspace_name").(string)
+
+	body := workspaceSettingsBody{Settings: expandSettings(d)}
+	body.Settings.Workspace = &restNamedLink{Name: workspaceName}
+
+	err := client.SendJSON(http.MethodPost, fmt.Sprintf("/workspaces/%s/settings", workspaceName), &body)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(workspaceName)
+
+	return resourceGeoserverWorkspaceSettingsRead(d, meta)
+}
+
+func resourceGeoserverWorkspaceSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Geoserver Workspace Settings: %s", d.Id())
+
+	client := meta.(*Config).RestClient()
+
+	var body workspaceSettingsBody
+	err := client.GetJSON(fmt.Sprintf("/workspaces/%s/settings.json", d.Id()), &body)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+
+	// Geoserver answers blank settings, without workspace, when the workspace has no local settings
+	if err != nil || body.Settings.Workspace == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("workspace_name", body.Settings.Workspace.Name)
+	flattenSettings(d, body.Settings)
+
+	return nil
+}
+
+func resourceGeoserverWorkspaceSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Geoserver Workspace Settings: %s", d.Id())
+
+	client := meta.(*Config).RestClient()
+
+	body := workspaceSettingsBody{Settings: expandSettings(d)}
+	body.Settings.Workspace = &restNamedLink{Name: d.Id()}
+
+	// The contact managed by geoserver_contact, not sent when the block is absent, is kept this way
+	return client.MergeJSON(fmt.Sprintf("/workspaces/%s/settings", d.Id()), &body)
+}
+
+func resourceGeoserverWorkspaceSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Geoserver Workspace Settings: %s", d.Id())
+
+	client := meta.(*Config).RestClient()
+
+	err := client.Delete(fmt.Sprintf("/workspaces/%s/settings", d.Id()))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceGeoserverWorkspaceSettingsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(d.Id())
+	d.Set("workspace_name", d.Id())
+
+	log.Printf("[INFO] Importing Geoserver Workspace Settings of workspace `%s`", d.Id())
+
+	err := resourceGeoserverWorkspaceSettingsRead(d, meta)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
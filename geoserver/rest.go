@@ -0,0 +1,137 @@
+package geoserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// RestClient performs raw calls on the Geoserver REST API, for the endpoints
+// not covered by the go-geoserver client
+type RestClient struct {
+	URL        string
+	Username   string
+	Password   string
+	HTTPClient *http.Client
+}
+
+// Do sends a request to the given path, relative to the REST API root, and
+// returns the body of the response. A 404 response is reported as a "not found"
+// error, as the go-geoserver client does.
+func (c *RestClient) Do(method string, path string, contentType string, body io.Reader) ([]byte, error) {
+	url := fmt.Sprintf("%s%s", strings.TrimSuffix(c.URL, "/"), path)
+
+	request, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	request.SetBasicAuth(c.Username, c.Password)
+	request.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	log.Printf("[DEBUG] %s %s", method, url)
+
+	response, err := c.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case response.StatusCode == http.StatusNotFound:
+		return nil, errors.New("not found")
+	case response.StatusCode >= 300:
+		return nil, fmt.Errorf("%s %s failed with status %d: %s", method, path, response.StatusCode, strings.TrimSpace(string(responseBody)))
+	}
+
+	return responseBody, nil
+}
+
+// GetJSON fetches the given path and decodes the JSON response into out
+func (c *RestClient) GetJSON(path string, out interface{}) error {
+	body, err := c.Do(http.MethodGet, path, "", nil)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// SendJSON encodes in as JSON and sends it to the given path
+func (c *RestClient) SendJSON(method string, path string, in interface{}) error {
+	payload, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(method, path, "application/json", bytes.NewReader(payload))
+	return err
+}
+
+// SendText sends a plain text body to the given path
+func (c *RestClient) SendText(method string, path string, text string) error {
+	_, err := c.Do(method, path, "text/plain", strings.NewReader(text))
+	return err
+}
+
+// Delete removes the object at the given path
+func (c *RestClient) Delete(path string) error {
+	_, err := c.Do(http.MethodDelete, path, "", nil)
+	return err
+}
+
+// MergeJSON fetches the object at the given path, overlays in on it and sends
+// it back with PUT. Geoserver resets the fields missing from a PUT, the fields
+// in does not know are kept this way. A missing object is created by the PUT.
+func (c *RestClient) MergeJSON(path string, in interface{}) error {
+	var current map[string]interface{}
+	err := c.GetJSON(fmt.Sprintf("%s.json", path), &current)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+
+	payload, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	var overlay map[string]interface{}
+	err = json.Unmarshal(payload, &overlay)
+	if err != nil {
+		return err
+	}
+
+	return c.SendJSON(http.MethodPut, path, mergeJSONObjects(current, overlay))
+}
+
+// mergeJSONObjects overlays the fields of overlay on base, recursing into the nested objects.
+// The translated texts, named international*, are replaced as a whole for the removed languages to go away.
+func mergeJSONObjects(base map[string]interface{}, overlay map[string]interface{}) map[string]interface{} {
+	if base == nil {
+		base = map[string]interface{}{}
+	}
+
+	for key, value := range overlay {
+		nestedOverlay, isObject := value.(map[string]interface{})
+		nestedBase, wasObject := base[key].(map[string]interface{})
+		if isObject && wasObject && !strings.HasPrefix(key, "international") {
+			base[key] = mergeJSONObjects(nestedBase, nestedOverlay)
+			continue
+		}
+		base[key] = value
+	}
+
+	return base
+}
@@ -0,0 +1,239 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// restRoles is the list of role names returned by the /security/roles endpoints
+type restRoles struct {
+	Roles restList[string] `json:"roles"`
+}
+
+// rolesPath returns the root of the roles of a role service, the default one when serviceName is empty
+func rolesPath(serviceName string) string {
+	if serviceName == "" {
+		return "/security/roles"
+	}
+	return fmt.Sprintf("/security/roles/service/%s", serviceName)
+}
+
+// listsRole tells whether the roles listed at path include roleName. A missing
+// role service, user or group is reported as a "not found" error.
+func listsRole(meta interface{}, path string, roleName string) (bool, error) {
+	client := meta.(*Config).RestClient()
+
+	var body restRoles
+	err := client.GetJSON(path, &body)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(body.Roles, roleName), nil
+}
+
+// postRole sends a POST without body, the roles API taking the names from the path only
+func postRole(meta interface{}, path string) error {
+	client := meta.(*Config).RestClient()
+
+	_, err := client.Do(http.MethodPost, path, "", nil)
+	return err
+}
+
+// readRoleAssignment tells whether the role is given to the user or the group, kind being user or group
+func readRoleAssignment(meta interface{}, serviceName string, kind string, memberName string, roleName string) (bool, error) {
+	found, err := listsRole(meta, fmt.Sprintf("%s/%s/%s", rolesPath(serviceName), kind, memberName), roleName)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return false, err
+	}
+
+	return err == nil && found, nil
+}
+
+func roleAssignmentPath(serviceName string, roleName string, kind string, memberName string) string {
+	return fmt.Sprintf("%s/role/%s/%s/%s", rolesPath(serviceName), roleName, kind, memberName)
+}
+
+func assignRole(meta interface{}, serviceName string, roleName string, kind string, memberName string) error {
+	return postRole(meta, roleAssignmentPath(serviceName, roleName, kind, memberName))
+}
+
+func unassignRole(meta interface{}, serviceName string, roleName string, kind string, memberName string) error {
+	client := meta.(*Config).RestClient()
+
+	return client.Delete(roleAssignmentPath(serviceName, roleName, kind, memberName))
+}
+
+// roleAssignment describes the association of a role to a user or a group, whose id is <service>/<user or group>/<role>
+type roleAssignment struct {
+	// kind is the path segment of the member, user or group
+	kind string
+	// label names the member in the logs and the descriptions
+	label string
+}
+
+func (a *roleAssignment) schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"service_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Name of the role service. If empty the default geoserver one will be used. Used to compute the id of the resource.",
+		},
+		fmt.Sprintf("%s_name", a.kind): {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("Name of the %s given the role. Used to compute the id of the resource.", a.label),
+		},
+		"role_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the role. Used to compute the id of the resource.",
+		},
+	}
+}
+
+func (a *roleAssignment) create(d *schema.ResourceData, meta interface{}) error {
+	serviceName := d.Get("service_name").(string)
+	memberName := d.Get(fmt.Sprintf("%s_name", a.kind)).(string)
+	roleName := d.Get("role_name").(string)
+
+	log.Printf("[INFO] Giving Geoserver Role %s to %s %s", roleName, a.label, memberName)
+
+	err := assignRole(meta, serviceName, roleName, a.kind, memberName)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", serviceName, memberName, roleName))
+
+	return a.read(d, meta)
+}
+
+func (a *roleAssignment) read(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Geoserver %s Role: %s", a.label, d.Id())
+
+	splittedID := strings.Split(d.Id(), "/")
+	if len(splittedID) != 3 {
+		return fmt.Errorf("invalid id %q, expected <service>/<%s>/<role> with an empty service for the default one", d.Id(), a.kind)
+	}
+	serviceName := splittedID[0]
+	memberName := splittedID[1]
+	roleName := splittedID[2]
+
+	found, err := readRoleAssignment(meta, serviceName, a.kind, memberName, roleName)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		d.SetId("")
+		return nil
+	}
+	d.Set("service_name", serviceName)
+	d.Set(fmt.Sprintf("%s_name", a.kind), memberName)
+	d.Set("role_name", roleName)
+
+	return nil
+}
+
+func (a *roleAssignment) delete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Removing Geoserver %s Role: %s", a.label, d.Id())
+
+	splittedID := strings.Split(d.Id(), "/")
+	if len(splittedID) != 3 {
+		return fmt.Errorf("invalid id %q, expected <service>/<%s>/<role> with an empty service for the default one", d.Id(), a.kind)
+	}
+	serviceName := splittedID[0]
+	memberName := splittedID[1]
+	roleName := splittedID[2]
+
+	err := unassignRole(meta, serviceName, roleName, a.kind, memberName)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func (a *roleAssignment) importState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[INFO] Importing Geoserver %s Role: %s", a.label, d.Id())
+
+	err := a.read(d, meta)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// restGroups is the list of group names returned by the /security/usergroup endpoints
+type restGroups struct {
+	Groups restList[string] `json:"groups"`
+}
+
+type restGroupUsers struct {
+	Users restUsers `json:"users"`
+}
+
+type restUser struct {
+	UserName string `json:"userName"`
+}
+
+type restUsers []restUser
+
+func (u *restUsers) UnmarshalJSON(data []byte) error {
+	// A group without members is serialized as an empty string
+	var empty string
+	if json.Unmarshal(data, &empty) == nil {
+		*u = restUsers{}
+		return nil
+	}
+
+	var users restList[restUser]
+	err := json.Unmarshal(data, &users)
+	if err != nil {
+		return err
+	}
+	*u = restUsers(users)
+	return nil
+}
+
+// userGroupPath returns the root of the users and groups of a user/group service, the default one when serviceName is empty
+func userGroupPath(serviceName string) string {
+	if serviceName == "" {
+		return "/security/usergroup"
+	}
+	return fmt.Sprintf("/security/usergroup/service/%s", serviceName)
+}
+
+// groupMembers returns the names of the users of a group. A missing service or group is reported as a "not found" error.
+func groupMembers(meta interface{}, serviceName string, groupName string) ([]string, error) {
+	client := meta.(*Config).RestClient()
+
+	var body restGroupUsers
+	err := client.GetJSON(fmt.Sprintf("%s/group/%s/users", userGroupPath(serviceName), groupName), &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []string
+	for _, user := range body.Users {
+		members = append(members, user.UserName)
+	}
+	return members, nil
+}
+
+func groupMembershipPath(serviceName string, userName string, groupName string) string {
+	return fmt.Sprintf("%s/user/%s/group/%s", userGroupPath(serviceName), userName, groupName)
+}
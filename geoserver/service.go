@@ -0,0 +1,250 @@
+package geoserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// restService holds the ServiceInfo fields shared by all the OGC services
+type restService struct {
+	Workspace         *restNamedLink `json:"workspace,omitempty"`
+	Name              string         `json:"name,omitempty"`
+	Enabled           bool           `json:"enabled"`
+	Title             string         `json:"title"`
+	Maintainer        string         `json:"maintainer"`
+	Abstract          string         `json:"abstrct"`
+	AccessConstraints string         `json:"accessConstraints"`
+	Fees              string         `json:"fees"`
+	OnlineResource    string         `json:"onlineResource"`
+	SchemaBaseURL     string         `json:"schemaBaseURL"`
+	Verbose           bool           `json:"verbose"`
+	CiteCompliant     bool           `json:"citeCompliant"`
+	Keywords          *restStrings   `json:"keywords"`
+}
+
+// ogcService describes how a service resource maps onto /services/{name}/settings, globally or for a workspace
+type ogcService struct {
+	// name is both the path segment and the root of the JSON representation, e.g. wfs
+	name string
+	// label names the service in the logs and the errors
+	label string
+	// serviceName is the name of the ServiceInfo, left as is when empty
+	serviceName string
+	// expand embeds the shared fields in the representation of the service. current is the
+	// representation in Geoserver, nil when missing, for the lists the service merges itself.
+	expand func(d *schema.ResourceData, service restService, current json.RawMessage) interface{}
+	// flatten reads the fields specific to the service
+	flatten func(d *schema.ResourceData, raw json.RawMessage) error
+}
+
+// serviceSchema adds the attributes shared by all the services to the attributes of a service
+func serviceSchema(label string, attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	attributes["workspace_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: fmt.Sprintf("Name of the workspace of the virtual %s service. The global service is managed when empty.", label),
+	}
+	attributes["enabled"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: fmt.Sprintf("Is the %s service enabled?", label),
+	}
+	attributes["title"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Title of the service",
+	}
+	attributes["maintainer"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Maintainer of the service",
+	}
+	attributes["abstract"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Description of the service",
+	}
+	attributes["access_constraints"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specific access constraints of the service",
+	}
+	attributes["fees"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Fee details for the service",
+	}
+	attributes["online_resource"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Additional online resources about the service",
+	}
+	attributes["schema_base_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "http://schemas.opengis.net",
+		Description: "Base URL of the schemas referenced by the responses. Default value is http://schemas.opengis.net.",
+	}
+	attributes["is_verbose"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Indent the XML responses. Default value is false.",
+	}
+	attributes["is_cite_compliant"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Strictly follow the OGC specification, for the CITE tests. Default value is false.",
+	}
+	attributes["keywords"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "Keywords of the service",
+	}
+
+	return attributes
+}
+
+func (s *ogcService) path(workspaceName string) string {
+	if workspaceName == "" {
+		return fmt.Sprintf("/services/%s/settings", s.name)
+	}
+	return fmt.Sprintf("/services/%s/workspaces/%s/settings", s.name, workspaceName)
+}
+
+// workspaceName returns the workspace of the service, the id being <name>_service_configuration[/<workspace>]
+func (s *ogcService) workspaceName(d *schema.ResourceData) string {
+	if strings.Contains(d.Id(), "/") {
+		return strings.Split(d.Id(), "/")[1]
+	}
+	return ""
+}
+
+func (s *ogcService) create(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Configuring %s Service", s.label)
+
+	workspaceName := d.Get("workspace_name").(string)
+
+	err := s.update(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if workspaceName == "" {
+		d.SetId(fmt.Sprintf("%s_service_configuration", s.name))
+	} else {
+		d.SetId(fmt.Sprintf("%s_service_configuration/%s", s.name, workspaceName))
+	}
+
+	return s.read(d, meta)
+}
+
+func (s *ogcService) read(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing %s Service configuration: %s", s.label, d.Id())
+
+	client := meta.(*Config).RestClient()
+
+	var body map[string]json.RawMessage
+	err := client.GetJSON(fmt.Sprintf("%s.json", s.path(s.workspaceName(d))), &body)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+
+	raw, ok := body[s.name]
+	if err != nil || !ok {
+		d.SetId("")
+		return nil
+	}
+
+	var service restService
+	err = json.Unmarshal(raw, &service)
+	if err != nil {
+		return fmt.Errorf("unable to decode the %s service configuration: %s", s.label, err)
+	}
+
+	if service.Workspace != nil {
+		d.Set("workspace_name", service.Workspace.Name)
+	}
+	d.Set("enabled", service.Enabled)
+	d.Set("title", service.Title)
+	d.Set("maintainer", service.Maintainer)
+	d.Set("abstract", service.Abstract)
+	d.Set("access_constraints", service.AccessConstraints)
+	d.Set("fees", service.Fees)
+	d.Set("online_resource", service.OnlineResource)
+	d.Set("schema_base_url", service.SchemaBaseURL)
+	d.Set("is_verbose", service.Verbose)
+	d.Set("is_cite_compliant", service.CiteCompliant)
+	d.Set("keywords", flattenStrings(service.Keywords))
+
+	return s.flatten(d, raw)
+}
+
+func (s *ogcService) update(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating %s Service configuration: %s", s.label, d.Id())
+
+	client := meta.(*Config).RestClient()
+
+	workspaceName := d.Get("workspace_name").(string)
+
+	service := restService{
+		Name:              s.serviceName,
+		Enabled:           d.Get("enabled").(bool),
+		Title:             d.Get("title").(string),
+		Maintainer:        d.Get("maintainer").(string),
+		Abstract:          d.Get("abstract").(string),
+		AccessConstraints: d.Get("access_constraints").(string),
+		Fees:              d.Get("fees").(string),
+		OnlineResource:    d.Get("online_resource").(string),
+		SchemaBaseURL:     d.Get("schema_base_url").(string),
+		Verbose:           d.Get("is_verbose").(bool),
+		CiteCompliant:     d.Get("is_cite_compliant").(bool),
+		Keywords:          expandStrings(d.Get("keywords").([]interface{})),
+	}
+	if workspaceName != "" {
+		service.Workspace = &restNamedLink{Name: workspaceName}
+	}
+
+	// MergeJSON replaces the lists as a whole, the services merging some of them need the current ones
+	var current map[string]json.RawMessage
+	err := client.GetJSON(fmt.Sprintf("%s.json", s.path(workspaceName)), &current)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+
+	// The settings not managed by the resource are kept as they are
+	return client.MergeJSON(s.path(workspaceName), map[string]interface{}{
+		s.name: s.expand(d, service, current[s.name]),
+	})
+}
+
+func (s *ogcService) delete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] %s Service cannot be deleted. Skipping action", s.label)
+
+	d.SetId("")
+
+	return nil
+}
+
+func (s *ogcService) importState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(d.Id())
+	d.Set("workspace_name", s.workspaceName(d))
+
+	log.Printf("[INFO] Importing %s Service configuration: %s", s.label, d.Id())
+
+	err := s.read(d, meta)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
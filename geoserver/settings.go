@@ -0,0 +1,282 @@
+package geoserver
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type restContact struct {
+	ContactPerson                    string                  `json:"contactPerson"`
+	ContactOrganization              string                  `json:"contactOrganization"`
+	ContactPosition                  string                  `json:"contactPosition"`
+	AddressType                      string                  `json:"addressType"`
+	Address                          string                  `json:"address"`
+	AddressDeliveryPoint             string                  `json:"addressDeliveryPoint"`
+	AddressCity                      string                  `json:"addressCity"`
+	AddressState                     string                  `json:"addressState"`
+	AddressPostalCode                string                  `json:"addressPostalCode"`
+	AddressCountry                   string                  `json:"addressCountry"`
+	ContactVoice                     string                  `json:"contactVoice"`
+	ContactFacsimile                 string                  `json:"contactFacsimile"`
+	ContactEmail                     string                  `json:"contactEmail"`
+	OnlineResource                   string                  `json:"onlineResource"`
+	Welcome                          string                  `json:"welcome"`
+	InternationalContactPerson       restInternationalString `json:"internationalContactPerson"`
+	InternationalContactOrganization restInternationalString `json:"internationalContactOrganization"`
+	InternationalContactPosition     restInternationalString `json:"internationalContactPosition"`
+	InternationalAddress             restInternationalString `json:"internationalAddress"`
+	InternationalAddressCity         restInternationalString `json:"internationalAddressCity"`
+	InternationalAddressState        restInternationalString `json:"internationalAddressState"`
+	InternationalAddressCountry      restInternationalString `json:"internationalAddressCountry"`
+	InternationalWelcome             restInternationalString `json:"internationalWelcome"`
+}
+
+// restSettings is the SettingsInfo shared by the global and the workspace settings
+type restSettings struct {
+	Workspace                    *restNamedLink `json:"workspace,omitempty"`
+	Contact                      *restContact   `json:"contact,omitempty"`
+	Charset                      string         `json:"charset"`
+	NumDecimals                  int            `json:"numDecimals"`
+	OnlineResource               string         `json:"onlineResource"`
+	ProxyBaseURL                 string         `json:"proxyBaseUrl"`
+	UseHeadersProxyURL           bool           `json:"useHeadersProxyURL"`
+	Verbose                      bool           `json:"verbose"`
+	VerboseExceptions            bool           `json:"verboseExceptions"`
+	LocalWorkspaceIncludesPrefix bool           `json:"localWorkspaceIncludesPrefix"`
+}
+
+// contactAttributes returns the attributes describing a contact, used as a block or as the attributes of a resource
+func contactAttributes() map[string]*schema.Schema {
+	attribute := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: description,
+		}
+	}
+
+	return map[string]*schema.Schema{
+		"contact_person":         attribute("Name of the contact person."),
+		"contact_organization":   attribute("Organization of the contact."),
+		"contact_position":       attribute("Position of the contact person in the organization."),
+		"address_type":           attribute("Type of the address, e.g. Work."),
+		"address":                attribute("Street of the address."),
+		"address_delivery_point": attribute("Delivery point of the address."),
+		"address_city":           attribute("City of the address."),
+		"address_state":          attribute("State or province of the address."),
+		"address_postal_code":    attribute("Postal code of the address."),
+		"address_country":        attribute("Country of the address."),
+		"contact_voice":          attribute("Phone number of the contact."),
+		"contact_facsimile":      attribute("Fax number of the contact."),
+		"contact_email":          attribute("Email address of the contact."),
+		"online_resource":        attribute("URL of the organization."),
+		"welcome":                attribute("Welcome message displayed on the home page of Geoserver."),
+
+		"international_contact_person":       internationalStringSchema("Translations of contact_person, keyed by language code."),
+		"international_contact_organization": internationalStringSchema("Translations of contact_organization, keyed by language code."),
+		"international_contact_position":     internationalStringSchema("Translations of contact_position, keyed by language code."),
+		"international_address":              internationalStringSchema("Translations of address, keyed by language code."),
+		"international_address_city":         internationalStringSchema("Translations of address_city, keyed by language code."),
+		"international_address_state":        internationalStringSchema("Translations of address_state, keyed by language code."),
+		"international_address_country":      internationalStringSchema("Translations of address_country, keyed by language code."),
+		"international_welcome":              internationalStringSchema("Translations of welcome, keyed by language code."),
+	}
+}
+
+// expandContact builds the contact from its attributes, get reading either a block or the resource itself
+func expandContact(get func(string) interface{}) *restContact {
+	return &restContact{
+		ContactPerson:        get("contact_person").(string),
+		ContactOrganization:  get("contact_organization").(string),
+		ContactPosition:      get("contact_position").(string),
+		AddressType:          get("address_type").(string),
+		Address:              get("address").(string),
+		AddressDeliveryPoint: get("address_delivery_point").(string),
+		AddressCity:          get("address_city").(string),
+		AddressState:         get("address_state").(string),
+		AddressPostalCode:    get("address_postal_code").(string),
+		AddressCountry:       get("address_country").(string),
+		ContactVoice:         get("contact_voice").(string),
+		ContactFacsimile:     get("contact_facsimile").(string),
+		ContactEmail:         get("contact_email").(string),
+		OnlineResource:       get("online_resource").(string),
+		Welcome:              get("welcome").(string),
+
+		InternationalContactPerson:       expandInternationalString(get("international_contact_person")),
+		InternationalContactOrganization: expandInternationalString(get("international_contact_organization")),
+		InternationalContactPosition:     expandInternationalString(get("international_contact_position")),
+		InternationalAddress:             expandInternationalString(get("international_address")),
+		InternationalAddressCity:         expandInternationalString(get("international_address_city")),
+		InternationalAddressState:        expandInternationalString(get("international_address_state")),
+		InternationalAddressCountry:      expandInternationalString(get("international_address_country")),
+		InternationalWelcome:             expandInternationalString(get("international_welcome")),
+	}
+}
+
+func flattenContact(contact *restContact) map[string]interface{} {
+	return map[string]interface{}{
+		"contact_person":         contact.ContactPerson,
+		"contact_organization":   contact.ContactOrganization,
+		"contact_position":       contact.ContactPosition,
+		"address_type":           contact.AddressType,
+		"address":                contact.Address,
+		"address_delivery_point": contact.AddressDeliveryPoint,
+		"address_city":           contact.AddressCity,
+		"address_state":          contact.AddressState,
+		"address_postal_code":    contact.AddressPostalCode,
+		"address_country":        contact.AddressCountry,
+		"contact_voice":          contact.ContactVoice,
+		"contact_facsimile":      contact.ContactFacsimile,
+		"contact_email":          contact.ContactEmail,
+		"online_resource":        contact.OnlineResource,
+		"welcome":                contact.Welcome,
+
+		"international_contact_person":       flattenInternationalString(contact.InternationalContactPerson),
+		"international_contact_organization": flattenInternationalString(contact.InternationalContactOrganization),
+		"international_contact_position":     flattenInternationalString(contact.InternationalContactPosition),
+		"international_address":              flattenInternationalString(contact.InternationalAddress),
+		"international_address_city":         flattenInternationalString(contact.InternationalAddressCity),
+		"international_address_state":        flattenInternationalString(contact.InternationalAddressState),
+		"international_address_country":      flattenInternationalString(contact.InternationalAddressCountry),
+		"international_welcome":              flattenInternationalString(contact.InternationalWelcome),
+	}
+}
+
+// contactSchema is the contact block of the settings resources, computed for geoserver_contact to manage the contact instead
+func contactSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Description: "Contact information advertised in the capabilities documents. The contact of Geoserver is kept when the block is absent, e.g. when it is managed by geoserver_contact.",
+		Elem: &schema.Resource{
+			Schema: contactAttributes(),
+		},
+	}
+}
+
+// expandContactBlock reads the contact block. Nil is returned, for the contact of Geoserver to be kept,
+// unless the block changes, the state of an absent block being the contact read from Geoserver.
+func expandContactBlock(d *schema.ResourceData) *restContact {
+	if !d.HasChange("contact") {
+		return nil
+	}
+
+	contact := map[string]interface{}{}
+	if list := d.Get("contact").([]interface{}); len(list) > 0 && list[0] != nil {
+		contact = list[0].(map[string]interface{})
+	}
+
+	return expandContact(func(key string) interface{} {
+		if value, ok := contact[key]; ok {
+			return value
+		}
+		if strings.HasPrefix(key, "international_") {
+			return nil
+		}
+		return ""
+	})
+}
+
+// flattenContactBlock returns the contact block, an empty contact being no block at all
+func flattenContactBlock(contact *restContact) []interface{} {
+	if contact == nil {
+		return []interface{}{}
+	}
+
+	block := flattenContact(contact)
+	for _, value := range block {
+		switch v := value.(type) {
+		case string:
+			if v != "" {
+				return []interface{}{block}
+			}
+		case map[string]interface{}:
+			if len(v) > 0 {
+				return []interface{}{block}
+			}
+		}
+	}
+	return []interface{}{}
+}
+
+// settingsAttributes returns the attributes shared by the global and the workspace settings
+func settingsAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"contact": contactSchema(),
+		"charset": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "UTF-8",
+			Description: "Character set of the XML responses. Default value is UTF-8.",
+		},
+		"num_decimals": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     8,
+			Description: "Maximum number of decimals of the coordinates in the GML and GeoJSON responses. Default value is 8.",
+		},
+		"online_resource": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "URL advertised as the online resource of the services.",
+		},
+		"proxy_base_url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Base URL of Geoserver as seen from the clients when it is behind a proxy. May contain ${X-Forwarded-Host} like placeholders when use_headers_proxy_url is true.",
+		},
+		"use_headers_proxy_url": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Compute the proxy base URL from the headers of the requests. Default value is false.",
+		},
+		"verbose": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Indent the XML responses. Default value is false.",
+		},
+		"verbose_exceptions": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Include the Java stack traces in the service exceptions. Default value is false.",
+		},
+		"local_workspace_includes_prefix": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Keep the workspace prefix of the layer names in the virtual services of a workspace. Default value is false.",
+		},
+	}
+}
+
+func expandSettings(d *schema.ResourceData) restSettings {
+	return restSettings{
+		Contact:                      expandContactBlock(d),
+		Charset:                      d.Get("charset").(string),
+		NumDecimals:                  d.Get("num_decimals").(int),
+		OnlineResource:               d.Get("online_resource").(string),
+		ProxyBaseURL:                 d.Get("proxy_base_url").(string),
+		UseHeadersProxyURL:           d.Get("use_headers_proxy_url").(bool),
+		Verbose:                      d.Get("verbose").(bool),
+		VerboseExceptions:            d.Get("verbose_exceptions").(bool),
+		LocalWorkspaceIncludesPrefix: d.Get("local_workspace_includes_prefix").(bool),
+	}
+}
+
+func flattenSettings(d *schema.ResourceData, settings restSettings) {
+	d.Set("contact", flattenContactBlock(settings.Contact))
+	d.Set("charset", settings.Charset)
+	d.Set("num_decimals", settings.NumDecimals)
+	d.Set("online_resource", settings.OnlineResource)
+	d.Set("proxy_base_url", settings.ProxyBaseURL)
+	d.Set("use_headers_proxy_url", settings.UseHeadersProxyURL)
+	d.Set("verbose", settings.Verbose)
+	d.Set("verbose_exceptions", settings.VerboseExceptions)
+	d.Set("local_workspace_includes_prefix", settings.LocalWorkspaceIncludesPrefix)
+}
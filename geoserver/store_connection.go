@@ -0,0 +1,33 @@
+package geoserver
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func validateConnectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Make Geoserver connect to the store after create and update, by listing the resources it could publish, and fail with the error of Geoserver if the connection fails. Default value is false.",
+	}
+}
+
+// validateStoreConnection makes Geoserver connect to a store by listing the resources available in it
+func validateStoreConnection(d *schema.ResourceData, meta interface{}, path string) error {
+	if !d.Get("validate_connection").(bool) {
+		return nil
+	}
+
+	client := meta.(*Config).RestClient()
+
+	_, err := client.Do(http.MethodGet, fmt.Sprintf("%s.json?list=available", path), "", nil)
+	if err != nil {
+		return fmt.Errorf("unable to connect to store %s: %s", d.Get("name").(string), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package geoserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// rewriteStoreLayerID rewrites the <workspace>/<store>/<layer> id of a layer published from a store,
+// once the layer or its store was renamed
+func rewriteStoreLayerID(id string, storeName string, layerName string) (string, error) {
+	splittedID := strings.Split(id, "/")
+	if len(splittedID) != 3 {
+		return "", fmt.Errorf("invalid id %q, expected <workspace>/<store>/<layer>", id)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", splittedID[0], storeName, layerName), nil
+}
+
+// updatedStoreName returns the store the layer is updated in. A store renamed in the same apply is
+// updated before its layers, which are then found under the new name.
+func updatedStoreName(d *schema.ResourceData, attribute string, currentStoreName string) string {
+	if d.HasChange(attribute) {
+		return d.Get(attribute).(string)
+	}
+	return currentStoreName
+}
+
+// forceNewOnStoreMove recreates a layer moved to another store. Geoserver cannot move a layer between
+// stores, so the change is only applied in place when the new store does not exist yet, i.e. when the
+// store of the layer is renamed in the same apply.
+func forceNewOnStoreMove(d *schema.ResourceDiff, meta interface{}, attribute string, storesPath string) error {
+	if d.Id() == "" || !d.HasChange(attribute) || !d.NewValueKnown(attribute) {
+		return nil
+	}
+
+	storeName := d.Get(attribute).(string)
+	if storeName == "" {
+		return d.ForceNew(attribute)
+	}
+
+	client := meta.(*Config).RestClient()
+
+	var store map[string]interface{}
+	err := client.GetJSON(fmt.Sprintf("/workspaces/%s/%s/%s.json", d.Get("workspace_name").(string), storesPath, storeName), &store)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return err
+	}
+	if err == nil {
+		return d.ForceNew(attribute)
+	}
+
+	return nil
+}
+
+// storeMoveError explains the failed update of a layer whose new store is not the renamed one
+func storeMoveError(kind string, id string, storeName string, err error) error {
+	return fmt.Errorf("unable to update %s %s in store %s: %s. Geoserver cannot move a %s to another store, only a rename of its store is followed: replace the resource to move it", kind, id, storeName, err, kind)
+}
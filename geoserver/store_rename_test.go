@@ -0,0 +1,87 @@
+package geoserver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestRewriteStoreLayerID(t *testing.T) {
+	cases := []struct {
+		name      string
+		id        string
+		storeName string
+		layerName string
+		expected  string
+	}{
+		{"unchanged", "ws/roads_store/roads", "roads_store", "roads", "ws/roads_store/roads"},
+		{"store renamed", "ws/roads_store/roads", "network", "roads", "ws/network/roads"},
+		{"layer renamed", "ws/roads_store/roads", "roads_store", "streets", "ws/roads_store/streets"},
+		{"both renamed", "ws/roads_store/roads", "network", "streets", "ws/network/streets"},
+		{"without store", "ws//roads", "", "streets", "ws//streets"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			id, err := rewriteStoreLayerID(c.id, c.storeName, c.layerName)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if id != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, id)
+			}
+		})
+	}
+}
+
+func TestRewriteStoreLayerIDInvalid(t *testing.T) {
+	for _, id := range []string{"", "ws", "ws/roads", "ws/store/roads/extra"} {
+		_, err := rewriteStoreLayerID(id, "store", "roads")
+		if err == nil {
+			t.Errorf("expected an error for id %q", id)
+		}
+	}
+}
+
+func TestUpdatedStoreName(t *testing.T) {
+	resource := resourceGeoserverFeatureType()
+
+	state := &terraform.InstanceState{
+		ID: "ws/roads_store/roads",
+		Attributes: map[string]string{
+			"workspace_name": "ws",
+			"datastore_name": "roads_store",
+			"name":           "roads",
+		},
+	}
+
+	d, err := schema.InternalMap(resource.Schema).Data(state, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if storeName := updatedStoreName(d, "datastore_name", "roads_store"); storeName != "roads_store" {
+		t.Errorf("expected the current store without change, got %q", storeName)
+	}
+
+	// The datastore was renamed in the same apply
+	d, err = schema.InternalMap(resource.Schema).Data(state, &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"datastore_name": {Old: "roads_store", New: "network"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if storeName := updatedStoreName(d, "datastore_name", "roads_store"); storeName != "network" {
+		t.Errorf("expected the renamed store, got %q", storeName)
+	}
+
+	id, err := rewriteStoreLayerID(d.Id(), updatedStoreName(d, "datastore_name", "roads_store"), d.Get("name").(string))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if id != "ws/network/roads" {
+		t.Errorf("expected the id to follow the renamed store, got %q", id)
+	}
+}